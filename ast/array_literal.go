@@ -0,0 +1,55 @@
+package ast
+
+import (
+	"fmt"
+	"interpreter/token"
+	"strings"
+)
+
+type ArrayLiteral struct {
+	OpeningBracket token.Token
+	ClosingBracket token.Token
+	Elements       []IExpr
+}
+
+func (a ArrayLiteral) TokenLiteral() string {
+	return a.OpeningBracket.Literal
+}
+
+func (a ArrayLiteral) Span() token.Span {
+	return a.OpeningBracket.Span.To(a.ClosingBracket.Span)
+}
+
+func (a ArrayLiteral) String() string {
+	var elements []string
+	for _, e := range a.Elements {
+		elements = append(elements, e.String())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+}
+
+func (a ArrayLiteral) expression() {}
+
+type IndexExpression struct {
+	OpeningBracket token.Token
+	ClosingBracket token.Token
+	Left           IExpr
+	Index          IExpr
+}
+
+func (i IndexExpression) TokenLiteral() string {
+	return i.OpeningBracket.Literal
+}
+
+func (i IndexExpression) Span() token.Span {
+	if i.Left == nil {
+		return i.OpeningBracket.Span.To(i.ClosingBracket.Span)
+	}
+	return i.Left.Span().To(i.ClosingBracket.Span)
+}
+
+func (i IndexExpression) String() string {
+	return fmt.Sprintf("(%s[%s])", i.Left, i.Index)
+}
+
+func (i IndexExpression) expression() {}
@@ -0,0 +1,31 @@
+package ast
+
+import (
+	"fmt"
+	"interpreter/token"
+)
+
+// AssignExpression is `target = value` or a compound form like
+// `target += value`. Operator tells which.
+type AssignExpression struct {
+	Operator token.Token
+	Target   IExpr
+	Value    IExpr
+}
+
+func (a AssignExpression) TokenLiteral() string {
+	return a.Operator.Literal
+}
+
+func (a AssignExpression) String() string {
+	return fmt.Sprintf("(%s %s %s)", a.Target, a.Operator.Literal, a.Value)
+}
+
+func (a AssignExpression) expression() {}
+
+func (a AssignExpression) Span() token.Span {
+	if a.Target == nil {
+		return spanTo(a.Operator.Span, a.Value)
+	}
+	return spanTo(a.Target.Span(), a.Value)
+}
@@ -4,11 +4,23 @@ import (
 	"bytes"
 	"fmt"
 	"interpreter/token"
+	"math/big"
 )
 
 type Node interface {
 	TokenLiteral() string
 	String() string
+	// Span is the part of the source the node was parsed from.
+	Span() token.Span
+}
+
+// spanTo extends start to the end of node. Nodes left nil by a failed parse
+// do not extend the span.
+func spanTo(start token.Span, node Node) token.Span {
+	if node == nil {
+		return start
+	}
+	return start.To(node.Span())
 }
 
 type Statement interface {
@@ -25,6 +37,20 @@ type Program struct {
 	Statements []Statement
 }
 
+func (p Program) TokenLiteral() string {
+	if len(p.Statements) > 0 {
+		return p.Statements[0].TokenLiteral()
+	}
+	return ""
+}
+
+func (p Program) Span() token.Span {
+	if len(p.Statements) == 0 {
+		return token.Span{}
+	}
+	return spanTo(p.Statements[0].Span(), p.Statements[len(p.Statements)-1])
+}
+
 func (p Program) String() string {
 	var out bytes.Buffer
 	for _, s := range p.Statements {
@@ -44,6 +70,10 @@ func (i *Identifier) String() string {
 
 func (i *Identifier) expression() {}
 
+func (i *Identifier) Span() token.Span {
+	return i.Token.Span
+}
+
 func (i *Identifier) TokenLiteral() string {
 	return i.Token.Literal
 }
@@ -60,6 +90,13 @@ func (statement *LetStatement) TokenLiteral() string {
 
 func (statement *LetStatement) statement() {}
 
+func (statement *LetStatement) Span() token.Span {
+	if statement.Value == nil {
+		return spanTo(statement.Token.Span, statement.Name)
+	}
+	return spanTo(statement.Token.Span, statement.Value)
+}
+
 func (statement *LetStatement) String() string {
 	var out bytes.Buffer
 	out.WriteString(statement.TokenLiteral() + " ")
@@ -83,6 +120,10 @@ func (r *ReturnStatement) TokenLiteral() string {
 
 func (r *ReturnStatement) statement() {}
 
+func (r *ReturnStatement) Span() token.Span {
+	return spanTo(r.Token.Span, r.Value)
+}
+
 func (r *ReturnStatement) String() string {
 	var out bytes.Buffer
 	out.WriteString(r.TokenLiteral() + " ")
@@ -93,6 +134,44 @@ func (r *ReturnStatement) String() string {
 	return out.String()
 }
 
+// BreakStatement leaves the innermost loop.
+type BreakStatement struct {
+	Token token.Token
+}
+
+func (b *BreakStatement) TokenLiteral() string {
+	return b.Token.Literal
+}
+
+func (b *BreakStatement) statement() {}
+
+func (b *BreakStatement) Span() token.Span {
+	return b.Token.Span
+}
+
+func (b *BreakStatement) String() string {
+	return b.Token.Literal + ";"
+}
+
+// ContinueStatement skips to the next iteration of the innermost loop.
+type ContinueStatement struct {
+	Token token.Token
+}
+
+func (c *ContinueStatement) TokenLiteral() string {
+	return c.Token.Literal
+}
+
+func (c *ContinueStatement) statement() {}
+
+func (c *ContinueStatement) Span() token.Span {
+	return c.Token.Span
+}
+
+func (c *ContinueStatement) String() string {
+	return c.Token.Literal + ";"
+}
+
 type ExpressionStatement struct {
 	Token      token.Token
 	Expression IExpr
@@ -104,6 +183,13 @@ func (e ExpressionStatement) TokenLiteral() string {
 
 func (e ExpressionStatement) statement() {}
 
+func (e ExpressionStatement) Span() token.Span {
+	if e.Expression == nil {
+		return e.Token.Span
+	}
+	return e.Expression.Span()
+}
+
 func (e ExpressionStatement) String() string {
 	if e.Expression != nil {
 		return e.Expression.String()
@@ -130,9 +216,15 @@ func (b BooleanLiteral) String() string {
 
 func (b BooleanLiteral) expression() {}
 
+func (b BooleanLiteral) Span() token.Span {
+	return b.Token.Span
+}
+
 type IntegerLiteral struct {
 	Token token.Token
 	Value int
+	// Big holds the value instead of Value when it does not fit in an int
+	Big *big.Int
 }
 
 func (i IntegerLiteral) TokenLiteral() string {
@@ -146,6 +238,10 @@ func (i IntegerLiteral) String() string {
 func (i IntegerLiteral) expression() {
 }
 
+func (i IntegerLiteral) Span() token.Span {
+	return i.Token.Span
+}
+
 type PrefixExpression struct {
 	Operator token.Token
 	Right    IExpr
@@ -161,6 +257,10 @@ func (p PrefixExpression) String() string {
 
 func (p PrefixExpression) expression() {}
 
+func (p PrefixExpression) Span() token.Span {
+	return spanTo(p.Operator.Span, p.Right)
+}
+
 type InfixExpression struct {
 	Operator token.Token
 	Left     IExpr
@@ -176,3 +276,10 @@ func (i InfixExpression) String() string {
 }
 
 func (i InfixExpression) expression() {}
+
+func (i InfixExpression) Span() token.Span {
+	if i.Left == nil {
+		return spanTo(i.Operator.Span, i.Right)
+	}
+	return spanTo(i.Left.Span(), i.Right)
+}
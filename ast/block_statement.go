@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"interpreter/token"
+	"strings"
 )
 
 type BlockStatement struct {
 	OpeningBracket token.Token
+	ClosingBracket token.Token
 	Statements     []Statement
 }
 
@@ -20,13 +22,18 @@ func (b BlockStatement) TokenLiteral() string {
 	return b.OpeningBracket.Literal
 }
 
+func (b BlockStatement) Span() token.Span {
+	return b.OpeningBracket.Span.To(b.ClosingBracket.Span)
+}
+
 func (b BlockStatement) String() string {
 	var out bytes.Buffer
 	out.WriteString("{\n")
 	for _, s := range b.Statements {
-		out.WriteString(fmt.Sprintf("  %v\n", s))
+		text := strings.ReplaceAll(fmt.Sprintf("%v", s), "\n", "\n  ")
+		out.WriteString("  " + text + "\n")
 	}
-	out.WriteString("}\n")
+	out.WriteString("}")
 	return out.String()
 }
 
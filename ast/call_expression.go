@@ -2,27 +2,48 @@ package ast
 
 import (
 	"fmt"
+	"interpreter/token"
 	"strings"
 )
 
 type CallExpression struct {
-	Function   IExpr
-	Parameters []IExpr
+	OpeningParen token.Token
+	ClosingParen token.Token
+	Function     IExpr
+	Parameters   []IExpr
 }
 
 func (c CallExpression) TokenLiteral() string {
+	if c.Function == nil {
+		return c.OpeningParen.Literal
+	}
 	return c.Function.TokenLiteral()
 }
 
+func (c CallExpression) Span() token.Span {
+	if c.Function == nil {
+		return c.OpeningParen.Span.To(c.ClosingParen.Span)
+	}
+	return c.Function.Span().To(c.ClosingParen.Span)
+}
+
 func (c CallExpression) String() string {
 	var params []string
 	for _, p := range c.Parameters {
-		params = append(params, p.TokenLiteral())
+		params = append(params, p.String())
+	}
+
+	function := c.Function.String()
+	// a function literal reads its own body up to the closing brace, and an
+	// arrow's body takes the call with it, so both need parentheses to still
+	// be called when the output is parsed again
+	if _, ok := c.Function.(FunctionLiteral); ok {
+		function = "(" + function + ")"
 	}
 
 	return fmt.Sprintf(
 		"%s(%s)",
-		c.TokenLiteral(),
+		function,
 		strings.Join(params, ", "),
 	)
 }
@@ -31,3 +52,24 @@ func (c CallExpression) expression() {
 	//TODO implement me
 	panic("implement me")
 }
+
+// NamedArgument is an argument passed by the name of its parameter,
+// `name: value`.
+type NamedArgument struct {
+	Name  *Identifier
+	Value IExpr
+}
+
+func (n NamedArgument) TokenLiteral() string {
+	return n.Name.TokenLiteral()
+}
+
+func (n NamedArgument) Span() token.Span {
+	return spanTo(n.Name.Span(), n.Value)
+}
+
+func (n NamedArgument) String() string {
+	return fmt.Sprintf("%s: %s", n.Name, n.Value)
+}
+
+func (n NamedArgument) expression() {}
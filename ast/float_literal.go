@@ -0,0 +1,23 @@
+package ast
+
+import "interpreter/token"
+
+type FloatLiteral struct {
+	Token token.Token
+	Value float64
+}
+
+func (f FloatLiteral) TokenLiteral() string {
+	return f.Token.Literal
+}
+
+func (f FloatLiteral) String() string {
+	return f.TokenLiteral()
+}
+
+func (f FloatLiteral) expression() {
+}
+
+func (f FloatLiteral) Span() token.Span {
+	return f.Token.Span
+}
@@ -0,0 +1,73 @@
+package ast
+
+import (
+	"fmt"
+	"interpreter/token"
+)
+
+// ForExpression is the C style `for (init; condition; update) { ... }`.
+// Each of the three clauses may be left out; a missing condition is true.
+type ForExpression struct {
+	Token     token.Token
+	Init      Statement
+	Condition IExpr
+	Update    IExpr
+	Body      *BlockStatement
+}
+
+func (f ForExpression) TokenLiteral() string {
+	return f.Token.Literal
+}
+
+func (f ForExpression) Span() token.Span {
+	if f.Body != nil {
+		return f.Token.Span.To(f.Body.Span())
+	}
+	return f.Token.Span
+}
+
+func (f ForExpression) String() string {
+	var init, condition, update string
+	if f.Init != nil {
+		// a let statement prints its own `;`
+		init = f.Init.String()
+	}
+	if len(init) == 0 || init[len(init)-1] != ';' {
+		init += ";"
+	}
+	if f.Condition != nil {
+		condition = " " + f.Condition.String()
+	}
+	if f.Update != nil {
+		update = " " + f.Update.String()
+	}
+	return fmt.Sprintf("%s (%s%s;%s) %s", f.Token.Literal, init, condition, update, f.Body)
+}
+
+func (f ForExpression) expression() {}
+
+// ForInExpression runs its body once for every element of an array, every
+// character of a string or every key of a hash.
+type ForInExpression struct {
+	Token    token.Token
+	Variable *Identifier
+	Iterable IExpr
+	Body     *BlockStatement
+}
+
+func (f ForInExpression) TokenLiteral() string {
+	return f.Token.Literal
+}
+
+func (f ForInExpression) Span() token.Span {
+	if f.Body != nil {
+		return f.Token.Span.To(f.Body.Span())
+	}
+	return spanTo(f.Token.Span, f.Iterable)
+}
+
+func (f ForInExpression) String() string {
+	return fmt.Sprintf("%s %s in %s %s", f.Token.Literal, f.Variable, f.Iterable, f.Body)
+}
+
+func (f ForInExpression) expression() {}
@@ -3,33 +3,77 @@ package ast
 import (
 	"fmt"
 	"interpreter/token"
+	"strings"
 )
 
+// FunctionLiteral is `fun name(parameters) { body }`. FunctionName is the
+// zero token for anonymous functions. Arrow functions parse into anonymous
+// function literals too; their Token is the first token of the parameters
+// and a body without braces opens with the `=>` token.
 type FunctionLiteral struct {
 	Token        token.Token
 	FunctionName token.Token
-	Parameters   []Identifier
+	Parameters   []Parameter
 	Body         BlockStatement
 }
 
+// Parameter is a parameter of a function literal: `name`, `name = default`
+// or the rest parameter `...name`, which collects the remaining arguments
+// into an array.
+type Parameter struct {
+	Name    Identifier
+	Default IExpr
+	Rest    bool
+}
+
+func (p Parameter) String() string {
+	switch {
+	case p.Rest:
+		return "..." + p.Name.String()
+	case p.Default != nil:
+		return fmt.Sprintf("%s = %s", p.Name.String(), p.Default)
+	}
+	return p.Name.String()
+}
+
 func (f FunctionLiteral) TokenLiteral() string {
 	return f.Token.Literal
 }
 
+func (f FunctionLiteral) Span() token.Span {
+	return f.Token.Span.To(f.Body.Span())
+}
+
 func (f FunctionLiteral) String() string {
 	var params []string
 	for _, p := range f.Parameters {
 		params = append(params, p.String())
 	}
+	if f.Token.Class != token.FUNCTION {
+		return fmt.Sprintf("(%s) => %s", strings.Join(params, ", "), f.arrowBody())
+	}
+	name := f.FunctionName.Literal
+	if name != "" {
+		name = " " + name
+	}
 	text := fmt.Sprintf(
-		"fun %s(%s) {\n%s}\n",
+		"%s%s(%s) %s",
 		f.TokenLiteral(),
-		f.FunctionName.Literal,
+		name,
+		strings.Join(params, ", "),
 		f.Body.String(),
 	)
 	return text
 }
 
+// arrowBody prints the body of an arrow function the way it was written.
+func (f FunctionLiteral) arrowBody() string {
+	if f.Body.OpeningBracket.Class == token.ARROW {
+		return f.Body.Statements[0].String()
+	}
+	return f.Body.String()
+}
+
 func (f FunctionLiteral) expression() {
 	//TODO implement me
 	panic("implement me")
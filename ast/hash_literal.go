@@ -0,0 +1,37 @@
+package ast
+
+import (
+	"fmt"
+	"interpreter/token"
+	"strings"
+)
+
+type HashPair struct {
+	Key   IExpr
+	Value IExpr
+}
+
+// HashLiteral keeps its pairs in source order.
+type HashLiteral struct {
+	OpeningBrace token.Token
+	ClosingBrace token.Token
+	Pairs        []HashPair
+}
+
+func (h HashLiteral) TokenLiteral() string {
+	return h.OpeningBrace.Literal
+}
+
+func (h HashLiteral) Span() token.Span {
+	return h.OpeningBrace.Span.To(h.ClosingBrace.Span)
+}
+
+func (h HashLiteral) String() string {
+	var pairs []string
+	for _, p := range h.Pairs {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", p.Key, p.Value))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
+}
+
+func (h HashLiteral) expression() {}
@@ -16,8 +16,26 @@ func (i IfExpression) TokenLiteral() string {
 	return i.Token.Literal
 }
 
+func (i IfExpression) Span() token.Span {
+	switch {
+	case i.Else != nil:
+		return i.Token.Span.To(i.Else.Span())
+	case i.Then != nil:
+		return i.Token.Span.To(i.Then.Span())
+	}
+	return spanTo(i.Token.Span, i.Predicate)
+}
+
 func (i IfExpression) String() string {
-	return fmt.Sprintf("%s%s %selse %s)", i.Token.Literal, i.Predicate, i.Then, i.Else)
+	text := fmt.Sprintf("%s (%s) %s", i.Token.Literal, i.Predicate, i.Then)
+	switch {
+	case i.Else == nil:
+		return text
+	case i.Else.OpeningBracket.Class == token.IF:
+		// an `else if` desugared by the parser, print it the way it was written
+		return text + " else " + i.Else.Statements[0].String()
+	}
+	return text + " else " + i.Else.String()
 }
 
 func (i IfExpression) expression() {}
@@ -0,0 +1,27 @@
+package ast
+
+import "interpreter/token"
+
+// SpreadExpression is `...value` in an argument list or array literal, which
+// puts the elements of the array value there one by one.
+type SpreadExpression struct {
+	Token token.Token
+	Value IExpr
+}
+
+func (s SpreadExpression) TokenLiteral() string {
+	return s.Token.Literal
+}
+
+func (s SpreadExpression) Span() token.Span {
+	return spanTo(s.Token.Span, s.Value)
+}
+
+func (s SpreadExpression) String() string {
+	if s.Value == nil {
+		return s.Token.Literal
+	}
+	return s.Token.Literal + s.Value.String()
+}
+
+func (s SpreadExpression) expression() {}
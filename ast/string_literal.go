@@ -0,0 +1,47 @@
+package ast
+
+import (
+	"fmt"
+	"interpreter/token"
+	"strings"
+	"unicode"
+)
+
+type StringLiteral struct {
+	Token token.Token
+	Value string
+}
+
+func (s StringLiteral) TokenLiteral() string {
+	return s.Token.Literal
+}
+
+// String quotes the value using only escapes the lexer understands, so the
+// output lexes back to the same value.
+func (s StringLiteral) String() string {
+	var out strings.Builder
+	out.WriteByte('"')
+	for _, ch := range s.Value {
+		switch {
+		case ch == '"' || ch == '\\':
+			out.WriteRune('\\')
+			out.WriteRune(ch)
+		case ch == '\n':
+			out.WriteString(`\n`)
+		case ch == '\t':
+			out.WriteString(`\t`)
+		case !unicode.IsPrint(ch):
+			out.WriteString(fmt.Sprintf(`\u{%x}`, ch))
+		default:
+			out.WriteRune(ch)
+		}
+	}
+	out.WriteByte('"')
+	return out.String()
+}
+
+func (s StringLiteral) expression() {}
+
+func (s StringLiteral) Span() token.Span {
+	return s.Token.Span
+}
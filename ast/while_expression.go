@@ -0,0 +1,29 @@
+package ast
+
+import (
+	"fmt"
+	"interpreter/token"
+)
+
+type WhileExpression struct {
+	Token     token.Token
+	Condition IExpr
+	Body      *BlockStatement
+}
+
+func (w WhileExpression) TokenLiteral() string {
+	return w.Token.Literal
+}
+
+func (w WhileExpression) Span() token.Span {
+	if w.Body != nil {
+		return w.Token.Span.To(w.Body.Span())
+	}
+	return spanTo(w.Token.Span, w.Condition)
+}
+
+func (w WhileExpression) String() string {
+	return fmt.Sprintf("%s (%s) %s", w.Token.Literal, w.Condition, w.Body)
+}
+
+func (w WhileExpression) expression() {}
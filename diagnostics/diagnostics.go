@@ -0,0 +1,131 @@
+package diagnostics
+
+import (
+	"bytes"
+	"fmt"
+	"interpreter/token"
+	"strconv"
+	"strings"
+)
+
+type Severity int
+
+const (
+	Error Severity = iota
+	Warning
+	Note
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	case Note:
+		return "note"
+	}
+	return "unknown"
+}
+
+// Diagnostic is a problem found in the source. It implements error so it can
+// travel through the same paths as plain errors; Render gives the long form.
+type Diagnostic struct {
+	Severity Severity
+	Code     string
+	Span     token.Span
+	Message  string
+	Hints    []string
+}
+
+// New creates an error diagnostic.
+func New(code string, span token.Span, format string, a ...any) Diagnostic {
+	return Diagnostic{
+		Severity: Error,
+		Code:     code,
+		Span:     span,
+		Message:  fmt.Sprintf(format, a...),
+	}
+}
+
+// WithHint returns a copy of d with one more hint attached.
+func (d Diagnostic) WithHint(format string, a ...any) Diagnostic {
+	hints := make([]string, len(d.Hints), len(d.Hints)+1)
+	copy(hints, d.Hints)
+	d.Hints = append(hints, fmt.Sprintf(format, a...))
+	return d
+}
+
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%v: %s", d.Span.Start, d.Message)
+}
+
+// Render prints the diagnostic together with the offending source line and
+// a caret underline, e.g.
+//
+//	error[E001]: expected class IDENT, got IF "if"
+//	 --> 1:5
+//	  |
+//	1 | let if = else;
+//	  |     ^^
+func (d Diagnostic) Render(source string) string {
+	var out bytes.Buffer
+
+	header := d.Severity.String()
+	if d.Code != "" {
+		header += "[" + d.Code + "]"
+	}
+	out.WriteString(fmt.Sprintf("%s: %s\n", header, d.Message))
+
+	line, ok := sourceLine(source, d.Span.Start.Line)
+	gutter := strings.Repeat(" ", len(strconv.Itoa(d.Span.Start.Line)))
+	out.WriteString(fmt.Sprintf("%s--> %v\n", gutter, d.Span.Start))
+	if ok {
+		out.WriteString(fmt.Sprintf("%s |\n", gutter))
+		out.WriteString(fmt.Sprintf("%d | %s\n", d.Span.Start.Line, line))
+		out.WriteString(fmt.Sprintf("%s | %s\n", gutter, underline(line, d.Span)))
+	}
+	for _, hint := range d.Hints {
+		out.WriteString(fmt.Sprintf("%s = hint: %s\n", gutter, hint))
+	}
+	return out.String()
+}
+
+func sourceLine(source string, line int) (string, bool) {
+	lines := strings.Split(source, "\n")
+	if line < 1 || line > len(lines) {
+		return "", false
+	}
+	return strings.TrimRight(lines[line-1], "\r"), true
+}
+
+// underline marks the span on its first line. Columns count runes, and tabs
+// in front of the span are kept so that the carets line up with the source.
+func underline(line string, span token.Span) string {
+	runes := []rune(line)
+	start := span.Start.Column - 1
+	if start > len(runes) {
+		start = len(runes)
+	}
+	end := len(runes)
+	if span.End.Line == span.Start.Line {
+		end = span.End.Column - 1
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var out bytes.Buffer
+	for _, ch := range runes[:start] {
+		if ch == '\t' {
+			out.WriteRune('\t')
+		} else {
+			out.WriteRune(' ')
+		}
+	}
+	if end <= start {
+		end = start + 1
+	}
+	out.WriteString(strings.Repeat("^", end-start))
+	return out.String()
+}
@@ -0,0 +1,94 @@
+package diagnostics
+
+import (
+	"interpreter/token"
+	"testing"
+)
+
+func span(line, startColumn, endColumn int) token.Span {
+	return token.Span{
+		Start: token.Position{Line: line, Column: startColumn},
+		End:   token.Position{Line: line, Column: endColumn},
+	}
+}
+
+func TestDiagnostic_Render(t *testing.T) {
+	tests := []struct {
+		source     string
+		diagnostic Diagnostic
+		expected   string
+	}{
+		{
+			"let if = else;",
+			New("E001", span(1, 5, 7), "expected class IDENT, got IF %q", "if"),
+			`error[E001]: expected class IDENT, got IF "if"
+ --> 1:5
+  |
+1 | let if = else;
+  |     ^^
+`,
+		},
+		{
+			"let x = 1;\n\tx + ;",
+			New("E002", span(2, 6, 7), "no prefix parse function").
+				WithHint("%q can not start an expression", ";"),
+			`error[E002]: no prefix parse function
+ --> 2:6
+  |
+2 | 	x + ;
+  | 	    ^
+  = hint: ";" can not start an expression
+`,
+		},
+		{
+			"(1",
+			New("E003", span(1, 3, 3), "missing parenthesis"),
+			`error[E003]: missing parenthesis
+ --> 1:3
+  |
+1 | (1
+  |   ^
+`,
+		},
+		{
+			"let größe = ;",
+			New("E002", span(1, 13, 14), "no prefix parse function"),
+			`error[E002]: no prefix parse function
+ --> 1:13
+  |
+1 | let größe = ;
+  |             ^
+`,
+		},
+		{
+			"",
+			Diagnostic{Severity: Warning, Span: span(7, 1, 2), Message: "far away"},
+			`warning: far away
+ --> 7:1
+`,
+		},
+	}
+	for i, tt := range tests {
+		if actual := tt.diagnostic.Render(tt.source); actual != tt.expected {
+			t.Errorf("tests[%d] - Render() wrong.\nexpected=\n%s\ngot=\n%s",
+				i, tt.expected, actual)
+		}
+	}
+}
+
+func TestDiagnostic_Error(t *testing.T) {
+	d := New("E001", span(3, 4, 5), "expected %s", "IDENT")
+	if d.Error() != "3:4: expected IDENT" {
+		t.Fatalf("Error() wrong. got=%q", d.Error())
+	}
+}
+
+func TestDiagnostic_WithHintDoesNotShareHints(t *testing.T) {
+	base := New("E001", span(1, 1, 2), "base").WithHint("first")
+	a := base.WithHint("a")
+	b := base.WithHint("b")
+	if len(base.Hints) != 1 || a.Hints[1] != "a" || b.Hints[1] != "b" {
+		t.Fatalf("hints are shared between copies. base=%v a=%v b=%v",
+			base.Hints, a.Hints, b.Hints)
+	}
+}
@@ -0,0 +1,106 @@
+package evaluator
+
+import (
+	"testing"
+)
+
+func Test_evalDefaultParameters(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"fun f(a, b = 2) { a * b } f(5)", 10},
+		{"fun f(a, b = 2) { a * b } f(5, 3)", 15},
+		{"fun f(a = 1, b = 2) { a * 10 + b } f()", 12},
+		{"fun f(a, b = a * 2) { a + b } f(3)", 9},
+		{"let n = 1; fun f(a = n) { a } n = 5; f()", 5},
+		{"let calls = 0; fun tick() { calls += 1 } fun f(a = tick()) { a } f(); f(0); f(); calls", 2},
+		{"let f = (x, step = 1) => x + step; f(1) + f(1, 10)", 13},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalRestAndSpread(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun f(...xs) { xs } f()", "[]"},
+		{"fun f(...xs) { xs } f(1, 2, 3)", "[1, 2, 3]"},
+		{"fun f(a, ...xs) { [a, xs] } f(1, 2, 3)", "[1, [2, 3]]"},
+		{"fun f(a, b = 0, ...xs) { [a, b, xs] } f(1)", "[1, 0, []]"},
+		{"fun f(a, b, c) { [c, b, a] } f(...[1, 2, 3])", "[3, 2, 1]"},
+		{"fun f(a, b, c) { [c, b, a] } f(1, ...[2], ...[], 3)", "[3, 2, 1]"},
+		{"fun f(...xs) { xs } let a = [1, 2]; f(...a, ...a)", "[1, 2, 1, 2]"},
+		{"let xs = [2, 3]; [1, ...xs, 4]", "[1, 2, 3, 4]"},
+		{"let f = (...xs) => xs; f(1, 2)", "[1, 2]"},
+		// the rest array is a copy of the spread one
+		{"fun f(...xs) { xs } let a = [1]; f(...a) == a", "false"},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, evaluated.Inspect())
+		}
+	}
+}
+
+func Test_evalNamedArguments(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"fun f(a, b) { a - b } f(b: 1, a: 10)", 9},
+		{"fun f(a, b = 2, c = 3) { a * 100 + b * 10 + c } f(1, c: 9)", 129},
+		{"fun f(a = 1, b = 2) { a * 10 + b } f(b: 5)", 15},
+		{"fun f(a, b = a) { a + b } f(a: 4)", 8},
+		{"fun f(a, ...xs) { a + len } let len = 0; f(a: 7)", 7},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalArgumentErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun add(a, b) { a + b } add(1)", "wrong number of arguments to add: want 2, got 1"},
+		{"fun add(a, b) { a + b } add(1, 2, 3)", "wrong number of arguments to add: want 2, got 3"},
+		{"fun f(a, b = 1) { a } f()", "wrong number of arguments to f: want 1 to 2, got 0"},
+		{"fun f(a, b = 1) { a } f(1, 2, 3)", "wrong number of arguments to f: want 1 to 2, got 3"},
+		{"fun f(a, b, ...xs) { a } f(1)", "wrong number of arguments to f: want at least 2, got 1"},
+		{"let f = (x) => x; f()", "wrong number of arguments to anonymous function: want 1, got 0"},
+		{"fun f(a, b) { a } f(...[1, 2, 3])", "wrong number of arguments to f: want 2, got 3"},
+		{"fun f(a, b) { a } f(b: 1)", "wrong number of arguments to f: want 2, got 1"},
+		{"fun f(a) { a } f(b: 1)", "f has no parameter b"},
+		{"fun f(a, ...xs) { a } f(1, xs: [])", "f has no parameter xs"},
+		{"fun f(a) { a } f(1, a: 2)", "f got two values for parameter a"},
+		{"fun f(a) { a } f(...1)", "can not spread INTEGER, only arrays"},
+		{"[...{}]", "can not spread HASH, only arrays"},
+		{"fun f(a = b) { a } f()", "identifier not found: b"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
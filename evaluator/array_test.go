@@ -0,0 +1,104 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"strings"
+	"testing"
+)
+
+func Test_evalArrayLiteral(t *testing.T) {
+	evaluated, err := testEval(t, "[1, 2 * 2, 3 + 3]")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	result, ok := evaluated.(*object.Array)
+	if !ok {
+		t.Fatalf("object is not Array. got=%T (%+v)", evaluated, evaluated)
+	}
+	if len(result.Elements) != 3 {
+		t.Fatalf("array has wrong num of elements. got=%d", len(result.Elements))
+	}
+	testIntegerObject(t, result.Elements[0], 1)
+	testIntegerObject(t, result.Elements[1], 4)
+	testIntegerObject(t, result.Elements[2], 6)
+	if result.Inspect() != "[1, 4, 6]" {
+		t.Errorf("Inspect() wrong. got=%q", result.Inspect())
+	}
+}
+
+func Test_evalArrayIndexExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"[1, 2, 3][0]", 1},
+		{"[1, 2, 3][1]", 2},
+		{"[1, 2, 3][2]", 3},
+		{"let i = 0; [1][i];", 1},
+		{"[1, 2, 3][1 + 1];", 3},
+		{"let myArray = [1, 2, 3]; myArray[2];", 3},
+		{"let myArray = [1, 2, 3]; myArray[0] + myArray[1] + myArray[2];", 6},
+		{"let myArray = [1, 2, 3]; let i = myArray[0]; myArray[i]", 2},
+		{"[[1, 2], [3, 4]][1][0]", 3},
+		{"fun id(x) { x }; [id][0](7)", 7},
+		{"[1, 2, 3][3]", nil},
+		{"[1, 2, 3][-1]", nil},
+		{"[][0]", nil},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		if integer, ok := tt.expected.(int); ok {
+			testIntegerObject(t, evaluated, integer)
+		} else {
+			testNullObject(t, evaluated)
+		}
+	}
+}
+
+func Test_evalIndexErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"[1, 2][true]", "array index must be INTEGER, got BOOLEAN"},
+		{"1[0]", "index operator not supported: INTEGER"},
+		{"[1, 2][missing]", "identifier not found: missing"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%q: wrong error. expected=%q, got=%v", tt.input, tt.expected, err)
+		}
+	}
+}
+
+func Test_evalArrayOfNoValues(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun f() { } [f()]", "[null]"},
+		{"let x = if (true) { let y = 1 }; [x]", "[null]"},
+		{"let x = if (true) { }; [x, x]", "[null, null]"},
+		{"[if (false) { 1 }]", "[null]"},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		array, ok := evaluated.(*object.Array)
+		if !ok {
+			t.Fatalf("%q: object is not Array. got=%T (%+v)", tt.input, evaluated, evaluated)
+		}
+		for _, element := range array.Elements {
+			testNullObject(t, element)
+		}
+		if array.Inspect() != tt.expected {
+			t.Errorf("%q: Inspect() wrong. expected=%q, got=%q", tt.input, tt.expected, array.Inspect())
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package evaluator
+
+import (
+	"interpreter/ast"
+	"interpreter/object"
+	"interpreter/token"
+)
+
+// dictCompoundOperator maps compound assignments to the operator they apply.
+var dictCompoundOperator = map[token.Class]token.Token{
+	token.PLUS_ASSIGN:     token.New(token.PLUS, "+"),
+	token.MINUS_ASSIGN:    token.New(token.MINUS, "-"),
+	token.ASTERISK_ASSIGN: token.New(token.ASTERISK, "*"),
+	token.SLASH_ASSIGN:    token.New(token.SLASH, "/"),
+	token.PERCENT_ASSIGN:  token.New(token.PERCENT, "%"),
+	token.POWER_ASSIGN:    token.New(token.POWER, "**"),
+}
+
+// evalAssignExpression updates an existing variable and yields the new value.
+// A compound assignment like `x += 1` works like `x = x + 1`.
+func evalAssignExpression(expr *ast.AssignExpression, env *object.Environment) (object.Object, error) {
+	target := expr.Target.(*ast.Identifier)
+	current, ok := env.Get(target.Value)
+	if !ok {
+		return nil, object.NewError("assignment to undeclared variable: %s", target.Value)
+	}
+
+	value, err := evalValue(expr.Value, env)
+	if err != nil {
+		return nil, err
+	}
+	if operator, ok := dictCompoundOperator[expr.Operator.Class]; ok {
+		value, err = evalInfixExpression(operator, current, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	env.Assign(target.Value, value)
+	return value, nil
+}
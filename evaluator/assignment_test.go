@@ -0,0 +1,97 @@
+package evaluator
+
+import (
+	"testing"
+)
+
+func Test_evalAssignExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let x = 1; x = 2; x", 2},
+		{"let x = 1; x = x + 1", 2},
+		{"let a = 0; let b = 0; a = b = 5; a + b", 10},
+		{"let x = 10; x += 5; x", 15},
+		{"let x = 10; x -= 5; x", 5},
+		{"let x = 10; x *= 5; x", 50},
+		{"let x = 10; x /= 5; x", 2},
+		{"let x = 10; x %= 4; x", 2},
+		{"let x = 2; x **= 10; x", 1024},
+		{"let x = 1; if (true) { x = 2 }; x", 2},
+		{"let x = 1; if (true) { let x = 5; x = 6 }; x", 1},
+		{"let x = 1; { x += 1; { x += 1 } }; x", 3},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalAssignmentInClosures(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{`
+let counter = fun makeCounter() {
+  let count = 0;
+  fun increment() { count += 1 }
+};
+let next = counter();
+next(); next();
+next()`, 3},
+		{`
+let makeCounter = fun makeCounter() {
+  let count = 0;
+  fun increment() { count += 1 }
+};
+let a = makeCounter();
+let b = makeCounter();
+a(); a(); b();
+a() * 10 + b()`, 32},
+		{`
+let total = 0;
+let add = fun add(n) { total += n };
+add(5); add(7);
+total`, 12},
+		{`
+let x = 1;
+let shadow = fun shadow(x) { x = 100 };
+shadow(5);
+x`, 1},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalAssignmentErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"x = 1", "assignment to undeclared variable: x"},
+		{"y += 1", "assignment to undeclared variable: y"},
+		{"let f = fun f() { z = 1 }; f()", "assignment to undeclared variable: z"},
+		{`let s = "a"; s -= 1`, "type mismatch: STRING - INTEGER"},
+		{"let x = 1; x /= 0", "division by zero: 1 / 0"},
+		{"let x = 1; x = y", "identifier not found: y"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
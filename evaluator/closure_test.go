@@ -0,0 +1,68 @@
+package evaluator
+
+import "testing"
+
+func Test_evalBlockScope(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let x = 1; { let y = x + 1; y }", 2},
+		{"let x = 1; { let x = 2; x }", 2},
+		{"let x = 1; { let x = 2; } x", 1},
+		{"let x = 1; if (true) { let x = 10; } x", 1},
+		{"let x = 1; { { { x + 2 } } }", 3},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalClosures(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{`
+fun makeAdder(x) { fun adder(y) { x + y } }
+let addTwo = makeAdder(2);
+addTwo(3);`, 5},
+		{`
+fun makeAdder(x) { fun adder(y) { x + y } }
+let addTwo = makeAdder(2);
+let addTen = makeAdder(10);
+addTwo(1) + addTen(1);`, 14},
+		{`
+let x = 1;
+fun getX() { x }
+fun shadow(x) { getX() }
+shadow(100);`, 1},
+		{`
+fun fib(n) { if (n < 2) { return n; } fib(n - 1) + fib(n - 2) }
+fib(10);`, 55},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalScopeErrors(t *testing.T) {
+	tests := []string{
+		"{ let y = 1; } y",
+		"fun f(a) { a } f(1); a",
+		"fun f() { let local = 1; } f(); local",
+	}
+	for _, input := range tests {
+		if _, err := testEval(t, input); err == nil {
+			t.Errorf("%q: binding leaked out of its scope", input)
+		}
+	}
+}
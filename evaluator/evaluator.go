@@ -0,0 +1,337 @@
+package evaluator
+
+import (
+	"fmt"
+	"interpreter/ast"
+	"interpreter/object"
+	"interpreter/token"
+	"math/big"
+)
+
+func Eval(node ast.Node, env *object.Environment) (object.Object, error) {
+	switch node := node.(type) {
+	case *ast.Program:
+		{
+			return evalProgram(node, env)
+		}
+	case *ast.ExpressionStatement:
+		{
+			if literal, ok := node.Expression.(ast.FunctionLiteral); ok && literal.FunctionName.Literal != "" {
+				return evalFunctionDeclaration(literal, env)
+			}
+			return Eval(node.Expression, env)
+		}
+	case ast.BlockStatement:
+		{
+			return evalBlockStatement(node, env, false)
+		}
+	case *ast.BreakStatement:
+		{
+			return object.BREAK, nil
+		}
+	case *ast.ContinueStatement:
+		{
+			return object.CONTINUE, nil
+		}
+	case *ast.LetStatement:
+		{
+			value, err := evalValue(node.Value, env)
+			if err != nil {
+				return nil, err
+			}
+			env.Set(node.Name.Value, value)
+			return object.NULL, nil
+		}
+	case *ast.ReturnStatement:
+		{
+			// whatever comes after, a return ends the function, so its call
+			// can wait for runCall. The ReturnValue is never used as a value
+			// on the way there: expressions pass it up (see evalValue) and
+			// only runCall and evalProgram unwrap it.
+			value, err := evalTail(node.Value, env)
+			if err != nil {
+				return nil, err
+			}
+			if isUnwinding(value) {
+				return value, nil
+			}
+			return &object.ReturnValue{Value: value}, nil
+		}
+	case *ast.IntegerLiteral:
+		{
+			if node.Big != nil {
+				return &object.BigInteger{Value: node.Big}, nil
+			}
+			return &object.Integer{Value: node.Value}, nil
+		}
+	case *ast.FloatLiteral:
+		{
+			return &object.Float{Value: node.Value}, nil
+		}
+	case *ast.StringLiteral:
+		{
+			return &object.String{Value: node.Value}, nil
+		}
+	case *ast.BooleanLiteral:
+		{
+			return object.NativeBoolToBooleanObject(node.Value), nil
+		}
+	case *ast.Identifier:
+		{
+			return evalIdentifier(node, env)
+		}
+	case *ast.PrefixExpression:
+		{
+			right, err := evalValue(node.Right, env)
+			if err != nil {
+				return nil, err
+			}
+			return evalPrefixExpression(node.Operator, right)
+		}
+	case *ast.InfixExpression:
+		{
+			if isLogicalOperator(node.Operator) {
+				return evalLogicalExpression(node, env)
+			}
+			left, err := evalValue(node.Left, env)
+			if err != nil {
+				return nil, err
+			}
+			right, err := evalValue(node.Right, env)
+			if err != nil {
+				return nil, err
+			}
+			return evalInfixExpression(node.Operator, left, right)
+		}
+	case *ast.AssignExpression:
+		{
+			return evalAssignExpression(node, env)
+		}
+	case ast.IfExpression:
+		{
+			return evalIfExpression(node, env, false)
+		}
+	case ast.WhileExpression:
+		{
+			return evalWhileExpression(node, env)
+		}
+	case ast.ForExpression:
+		{
+			return evalForExpression(node, env)
+		}
+	case ast.ForInExpression:
+		{
+			return evalForInExpression(node, env)
+		}
+	case ast.FunctionLiteral:
+		{
+			return evalFunctionLiteral(node, env)
+		}
+	case ast.CallExpression:
+		{
+			return evalCallExpression(node, env)
+		}
+	case ast.ArrayLiteral:
+		{
+			elements, err := evalExpressions(node.Elements, env)
+			if err != nil {
+				return nil, err
+			}
+			return &object.Array{Elements: elements}, nil
+		}
+	case ast.HashLiteral:
+		{
+			return evalHashLiteral(node, env)
+		}
+	case ast.IndexExpression:
+		{
+			return evalIndexExpression(node, env)
+		}
+	}
+	return nil, object.NewError("can not evaluate %T", node)
+}
+
+func evalProgram(program *ast.Program, env *object.Environment) (object.Object, error) {
+	var result object.Object = object.NULL
+	for _, stmt := range program.Statements {
+		var err error
+		result, err = catchUnwinding(Eval(stmt, env))
+		if err != nil {
+			return nil, err
+		}
+		if r, ok := result.(*object.ReturnValue); ok {
+			// a return outside of any function may still hold a tail call
+			if call, ok := r.Value.(*object.TailCall); ok {
+				return runCall(call)
+			}
+			return r.Value, nil
+		}
+	}
+	return result, nil
+}
+
+// evalBlockStatement runs the block in its own scope and leaves return
+// values wrapped so that they keep unwinding through enclosing blocks until
+// a function call or the program unwraps them. Break and continue unwind the
+// same way up to their loop. In tail position, the last statement is too.
+// An empty block, like a let, evaluates to null.
+func evalBlockStatement(block ast.BlockStatement, env *object.Environment, tail bool) (object.Object, error) {
+	var result object.Object = object.NULL
+	scope := object.NewEnclosedEnvironment(env)
+	for i, stmt := range block.Statements {
+		var err error
+		if tail && i == len(block.Statements)-1 {
+			result, err = catchUnwinding(evalTail(stmt, scope))
+		} else {
+			result, err = catchUnwinding(Eval(stmt, scope))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isUnwinding(result) {
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+// unwinding carries a return, break or continue out of an expression whose
+// value is used, up to the statement the expression is in. It travels as an
+// error so that every expression passes it up the way it passes up errors.
+type unwinding struct {
+	signal object.Object
+}
+
+func (u *unwinding) Error() string {
+	return fmt.Sprintf("%s outside of its function or loop", u.signal.Inspect())
+}
+
+func isUnwinding(obj object.Object) bool {
+	switch obj.(type) {
+	case *object.ReturnValue, *object.Break, *object.Continue:
+		return true
+	}
+	return false
+}
+
+// evalValue evaluates an expression whose value is used. A return, break or
+// continue inside it leaves the expression as an *unwinding error.
+func evalValue(node ast.Node, env *object.Environment) (object.Object, error) {
+	value, err := Eval(node, env)
+	if err != nil {
+		return nil, err
+	}
+	if isUnwinding(value) {
+		return nil, &unwinding{signal: value}
+	}
+	return value, nil
+}
+
+// catchUnwinding turns an *unwinding error back into the return, break or
+// continue it carries, as the result of the statement it left.
+func catchUnwinding(result object.Object, err error) (object.Object, error) {
+	if u, ok := err.(*unwinding); ok {
+		return u.signal, nil
+	}
+	return result, err
+}
+
+func evalIdentifier(identifier *ast.Identifier, env *object.Environment) (object.Object, error) {
+	if value, ok := env.Get(identifier.Value); ok {
+		return value, nil
+	}
+	return nil, object.NewError("identifier not found: %s", identifier.Value)
+}
+
+func evalPrefixExpression(operator token.Token, right object.Object) (object.Object, error) {
+	switch operator.Class {
+	case token.BANG:
+		{
+			return object.NativeBoolToBooleanObject(!isTruthy(right)), nil
+		}
+	case token.MINUS:
+		{
+			switch number := right.(type) {
+			case *object.Integer:
+				if number.Value == minInt {
+					return object.NewInteger(new(big.Int).Neg(big.NewInt(int64(minInt)))), nil
+				}
+				return &object.Integer{Value: -number.Value}, nil
+			case *object.BigInteger:
+				return object.NewInteger(new(big.Int).Neg(number.Value)), nil
+			case *object.Float:
+				return &object.Float{Value: -number.Value}, nil
+			}
+			return nil, object.NewError("unknown operator: -%s", right.Type())
+		}
+	}
+	return nil, object.NewError("unknown operator: %s%s", operator.Literal, right.Type())
+}
+
+func evalInfixExpression(operator token.Token, left, right object.Object) (object.Object, error) {
+	switch {
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ:
+		{
+			l, lok := left.(*object.Integer)
+			r, rok := right.(*object.Integer)
+			if lok && rok {
+				return evalIntegerInfixExpression(operator, l.Value, r.Value)
+			}
+			bigLeft, _ := object.ToBig(left)
+			bigRight, _ := object.ToBig(right)
+			return evalBigIntegerInfixExpression(operator, bigLeft, bigRight)
+		}
+	case isNumber(left) && isNumber(right):
+		{
+			return evalFloatInfixExpression(operator, toFloat(left), toFloat(right))
+		}
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ &&
+		operator.Class == token.PLUS:
+		{
+			return &object.String{
+				Value: left.(*object.String).Value + right.(*object.String).Value,
+			}, nil
+		}
+	case operator.Class == token.EQUAL:
+		{
+			return object.NativeBoolToBooleanObject(object.Equals(left, right)), nil
+		}
+	case operator.Class == token.UNEQUAL:
+		{
+			return object.NativeBoolToBooleanObject(!object.Equals(left, right)), nil
+		}
+	case left.Type() != right.Type():
+		{
+			return nil, object.NewError(
+				"type mismatch: %s %s %s",
+				left.Type(), operator.Literal, right.Type(),
+			)
+		}
+	}
+	return nil, object.NewError(
+		"unknown operator: %s %s %s",
+		left.Type(), operator.Literal, right.Type(),
+	)
+}
+
+func evalIfExpression(expr ast.IfExpression, env *object.Environment, tail bool) (object.Object, error) {
+	predicate, err := evalValue(expr.Predicate, env)
+	if err != nil {
+		return nil, err
+	}
+	if isTruthy(predicate) {
+		return evalBlockStatement(*expr.Then, env, tail)
+	}
+	if expr.Else != nil {
+		return evalBlockStatement(*expr.Else, env, tail)
+	}
+	return object.NULL, nil
+}
+
+func isTruthy(obj object.Object) bool {
+	switch obj {
+	case object.NULL, object.FALSE:
+		return false
+	}
+	return true
+}
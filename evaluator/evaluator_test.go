@@ -0,0 +1,288 @@
+package evaluator
+
+import (
+	"interpreter/lexer"
+	"interpreter/object"
+	"interpreter/parser"
+	"strings"
+	"testing"
+)
+
+func testEval(t *testing.T, input string) (object.Object, error) {
+	l := lexer.New(input)
+	p := parser.New(&l)
+	program, err := p.ParseProgram()
+	if err != nil || len(p.Errors()) > 0 {
+		t.Fatalf("parser has errors for %q: %v", input, p.Errors())
+	}
+	return Eval(program, object.NewEnvironment())
+}
+
+func testIntegerObject(t *testing.T, obj object.Object, expected int) bool {
+	result, ok := obj.(*object.Integer)
+	if !ok {
+		t.Errorf("object is not Integer. got=%T (%+v)", obj, obj)
+		return false
+	}
+	if result.Value != expected {
+		t.Errorf("object has wrong value. got=%d, want=%d",
+			result.Value, expected)
+		return false
+	}
+	return true
+}
+
+func testBooleanObject(t *testing.T, obj object.Object, expected bool) bool {
+	result, ok := obj.(*object.Boolean)
+	if !ok {
+		t.Errorf("object is not Boolean. got=%T (%+v)", obj, obj)
+		return false
+	}
+	if result.Value != expected {
+		t.Errorf("object has wrong value. got=%t, want=%t",
+			result.Value, expected)
+		return false
+	}
+	return true
+}
+
+func testNullObject(t *testing.T, obj object.Object) bool {
+	if obj != object.NULL {
+		t.Errorf("object is not Null. got=%T (%+v)", obj, obj)
+		return false
+	}
+	return true
+}
+
+func Test_evalIntegerExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"5", 5},
+		{"10", 10},
+		{"-5", -5},
+		{"-10", -10},
+		{"5 + 5 + 5 + 5 - 10", 10},
+		{"2 * 2 * 2 * 2 * 2", 32},
+		{"-50 + 100 + -50", 0},
+		{"5 * 2 + 10", 20},
+		{"5 + 2 * 10", 25},
+		{"20 + 2 * -10", 0},
+		{"50 / 2 * 2 + 10", 60},
+		{"2 * (5 + 10)", 30},
+		{"3 * 3 * 3 + 10", 37},
+		{"3 * (3 * 3) + 10", 37},
+		{"(5 + 10 * 2 + 15 / 3) * 2 + -10", 50},
+		{"7 % 3", 1},
+		{"-7 % 3", -1},
+		{"7 % -3", 1},
+		{"2 + 10 % 4 * 3", 8},
+		{"(-9223372036854775807 - 1) % -1", 0},
+		{"99999999999999999999 % 7", 1},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalBooleanExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"true", true},
+		{"false", false},
+		{"!true", false},
+		{"!(!true)", true},
+		{"!!true", true},
+		{"!5", false},
+		{"1 < 2", true},
+		{"1 > 2", false},
+		{"1 <= 2", true},
+		{"2 <= 2", true},
+		{"3 <= 2", false},
+		{"1 >= 2", false},
+		{"2 >= 2", true},
+		{"1 + 1 >= 2 == true", true},
+		{"1.5 <= 1", false},
+		{"2 >= 1.5", true},
+		{"99999999999999999999 >= 99999999999999999999", true},
+		{"1 <= 99999999999999999999", true},
+		{"1 == 1", true},
+		{"1 != 1", false},
+		{"true == true", true},
+		{"true != false", true},
+		{"(1 < 2) == true", true},
+		{"(1 > 2) == true", false},
+		{"1 == true", false},
+		{"1 != true", true},
+		{"if (false) { 1 } == if (false) { 2 }", true},
+		{"fun f() {} f() == if (false) { 1 }", true},
+		{"let a = if (true) { let b = 1 }; a == if (true) { }", true},
+		{"fun f() {} !f()", true},
+		{"!if (true) { }", true},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalIfExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"if (true) { 10 }", 10},
+		{"if (false) { 10 }", nil},
+		{"if (1) { 10 }", 10},
+		{"if (1 < 2) { 10 }", 10},
+		{"if (1 > 2) { 10 }", nil},
+		{"if (1 > 2) { 10 } else { 20 }", 20},
+		{"if (1 < 2) { 10 } else { 20 }", 10},
+		{"let x = 2; if (x == 1) { 10 } else if (x == 2) { 20 } else { 30 }", 20},
+		{"let x = 3; if (x == 1) { 10 } else if (x == 2) { 20 } else { 30 }", 30},
+		{"let x = 3; if (x == 1) { 10 } else if (x == 2) { 20 }", nil},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		if integer, ok := tt.expected.(int); ok {
+			testIntegerObject(t, evaluated, integer)
+		} else {
+			testNullObject(t, evaluated)
+		}
+	}
+}
+
+func Test_evalReturnStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"return 10;", 10},
+		{"return 10; 9;", 10},
+		{"return 2 * 5; 9;", 10},
+		{"9; return 2 * 5; 9;", 10},
+		{"if (10 > 1) { if (10 > 1) { return 10; } return 1; }", 10},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalLetStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let a = 5; a;", 5},
+		{"let a = 5 * 5; a;", 25},
+		{"let a = 5; let b = a; b;", 5},
+		{"let a = 5; let b = a; let c = a + b + 5; c;", 15},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalFunctionCall(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"fun identity(x) { x; } identity(5);", 5},
+		{"fun identity(x) { return x; } identity(5);", 5},
+		{"fun double(x) { x * 2; } double(5);", 10},
+		{"fun add(x, y) { x + y; } add(5, 5);", 10},
+		{"fun add(x, y) { x + y; } add(5 + 5, add(5, 5));", 20},
+		{"fun five() { 5 } five()", 5},
+		{"fun fact(n) { if (n < 2) { return 1; } n * fact(n - 1) } fact(5)", 120},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"5 + true;", "type mismatch: INTEGER + BOOLEAN"},
+		{"5 + true; 5;", "type mismatch: INTEGER + BOOLEAN"},
+		{"-true", "unknown operator: -BOOLEAN"},
+		{"true + false;", "unknown operator: BOOLEAN + BOOLEAN"},
+		{"if (10 > 1) { return true + false; }", "unknown operator: BOOLEAN + BOOLEAN"},
+		{"foobar", "identifier not found: foobar"},
+		{"5 / 0", "division by zero"},
+		{"5 % 0", "modulo by zero: 5 % 0"},
+		{"99999999999999999999 % 0", "modulo by zero: 99999999999999999999 % 0"},
+		{"true <= false", "unknown operator: BOOLEAN <= BOOLEAN"},
+		{`"a" >= 1`, "type mismatch: STRING >= INTEGER"},
+		{"fun f(x) { x } f(1, 2)", "wrong number of arguments to f: want 1, got 2"},
+		{"let x = 1; x(1)", "not a function: INTEGER"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		errObj, ok := err.(*object.Error)
+		if !ok {
+			t.Errorf("%q: no error object returned. got=%T(%+v)", tt.input, err, err)
+			continue
+		}
+		if !strings.Contains(errObj.Message, tt.expected) {
+			t.Errorf("%q: wrong error message. expected=%q, got=%q",
+				tt.input, tt.expected, errObj.Message)
+		}
+	}
+}
+
+func Test_evalReturnInsideExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"fun f() { let a = if (true) { return 1 }; 5 } f()", 1},
+		{"fun f() { 10 + if (true) { return 2 } } f()", 2},
+		{"fun f() { -if (true) { return 3 } } f()", 3},
+		{"fun f() { [1, if (true) { return 4 }, 3]; 5 } f()", 4},
+		{"fun g(x) { x } fun f() { g(if (true) { return 5 }); 0 } f()", 5},
+		{"fun f() { let h = {if (true) { return 6 }: 1}; 0 } f()", 6},
+		{"fun f() { let x = 0; x = if (true) { return 7 }; x } f()", 7},
+		{"fun f() { if (if (true) { return 8 }) { 1 }; 0 } f()", 8},
+		{"fun f() { true && if (true) { return 9 }; 0 } f()", 9},
+		{"let a = if (true) { return 10 }; 0", 10},
+		{"let n = 0; while (true) { let a = if (true) { break }; n += 1 }; n", 0},
+		{"let n = 0; for x in [1, 2, 3] { n += if (x == 2) { continue } else { x } }; n", 4},
+		{"let n = 0; while (n < 5) { let a = [if (n == 3) { break }]; n += 1 }; n", 3},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
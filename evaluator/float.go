@@ -0,0 +1,83 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"interpreter/token"
+	"math"
+	"math/big"
+)
+
+// isNumber reports whether obj is an integer or a float. An operator with an
+// integer on one side and a float on the other converts the integer to a
+// float, so 1 + 0.5 == 1.5 and 1 == 1.0.
+func isNumber(obj object.Object) bool {
+	switch obj.(type) {
+	case *object.Integer, *object.BigInteger, *object.Float:
+		return true
+	}
+	return false
+}
+
+func toFloat(obj object.Object) float64 {
+	switch number := obj.(type) {
+	case *object.Integer:
+		return float64(number.Value)
+	case *object.BigInteger:
+		f, _ := new(big.Float).SetInt(number.Value).Float64()
+		return f
+	}
+	return obj.(*object.Float).Value
+}
+
+func evalFloatInfixExpression(operator token.Token, left, right float64) (object.Object, error) {
+	switch operator.Class {
+	case token.PLUS:
+		return &object.Float{Value: left + right}, nil
+	case token.MINUS:
+		return &object.Float{Value: left - right}, nil
+	case token.ASTERISK:
+		return &object.Float{Value: left * right}, nil
+	case token.SLASH:
+		{
+			if right == 0 {
+				return nil, object.NewError("division by zero: %g / %g", left, right)
+			}
+			return &object.Float{Value: left / right}, nil
+		}
+	case token.PERCENT:
+		{
+			if right == 0 {
+				return nil, object.NewError("modulo by zero: %g %% %g", left, right)
+			}
+			return &object.Float{Value: math.Mod(left, right)}, nil
+		}
+	case token.POWER:
+		return evalFloatPower(left, right)
+	case token.LT:
+		return object.NativeBoolToBooleanObject(left < right), nil
+	case token.GT:
+		return object.NativeBoolToBooleanObject(left > right), nil
+	case token.LTE:
+		return object.NativeBoolToBooleanObject(left <= right), nil
+	case token.GTE:
+		return object.NativeBoolToBooleanObject(left >= right), nil
+	case token.EQUAL:
+		return object.NativeBoolToBooleanObject(left == right), nil
+	case token.UNEQUAL:
+		return object.NativeBoolToBooleanObject(left != right), nil
+	}
+	return nil, object.NewError("unknown operator: FLOAT %s FLOAT", operator.Literal)
+}
+
+// evalFloatPower follows math.Pow, except that 0 to a negative power is a
+// division by zero like it is for integers, and a negative number to a
+// fractional power, which has no real result, is an error rather than NaN.
+func evalFloatPower(left, right float64) (object.Object, error) {
+	if left == 0 && right < 0 {
+		return nil, object.NewError("division by zero: %g ** %g", left, right)
+	}
+	if left < 0 && right != math.Trunc(right) && !math.IsInf(right, 0) {
+		return nil, object.NewError("no real result: %g ** %g", left, right)
+	}
+	return &object.Float{Value: math.Pow(left, right)}, nil
+}
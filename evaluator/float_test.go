@@ -0,0 +1,88 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"testing"
+)
+
+func testFloatObject(t *testing.T, obj object.Object, expected float64) bool {
+	result, ok := obj.(*object.Float)
+	if !ok {
+		t.Errorf("object is not Float. got=%T (%+v)", obj, obj)
+		return false
+	}
+	if result.Value != expected {
+		t.Errorf("object has wrong value. got=%g, want=%g",
+			result.Value, expected)
+		return false
+	}
+	return true
+}
+
+func Test_evalFloatExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"3.5", 3.5},
+		{"-2.5", -2.5},
+		{"0.1 + 0.2", 0.30000000000000004},
+		{"1.5 * 4.0", 6},
+		{"1 + 0.5", 1.5},
+		{"0.5 + 1", 1.5},
+		{"7 / 2.0", 3.5},
+		{"2 * 1e3", 2000},
+		{"0xFF - 0.5", 254.5},
+		{"7.5 % 2", 1.5},
+		{"-7.5 % 2", -1.5},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testFloatObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalMixedNumberComparisons(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"1 == 1.0", true},
+		{"1.0 != 1", false},
+		{"1 < 1.5", true},
+		{"2.5 > 3", false},
+		{"0.1 + 0.2 == 0.3", false},
+		{"7 / 2 == 3", true},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalFloatErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.5 / 0", "division by zero: 1.5 / 0"},
+		{"1.5 % 0", "modulo by zero: 1.5 % 0"},
+		{"1.5 + true", "type mismatch: FLOAT + BOOLEAN"},
+		{`"a" * 2.0`, "type mismatch: STRING * FLOAT"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
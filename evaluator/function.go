@@ -0,0 +1,263 @@
+package evaluator
+
+import (
+	"fmt"
+	"interpreter/ast"
+	"interpreter/object"
+	"strconv"
+)
+
+// evalFunctionLiteral makes a closure over env. A named function sees
+// itself under its name through a scope of its own, so that it can recurse
+// wherever it ends up.
+func evalFunctionLiteral(literal ast.FunctionLiteral, env *object.Environment) (*object.Function, error) {
+	function := &object.Function{
+		Name:       literal.FunctionName.Literal,
+		Parameters: literal.Parameters,
+		Body:       literal.Body,
+		Env:        env,
+	}
+	if function.Name != "" {
+		function.Env = object.NewEnclosedEnvironment(env)
+		function.Env.Set(function.Name, function)
+	}
+	return function, nil
+}
+
+// evalFunctionDeclaration evaluates a named function literal that stands as
+// a statement of its own, which also binds the name in env.
+func evalFunctionDeclaration(literal ast.FunctionLiteral, env *object.Environment) (object.Object, error) {
+	function, err := evalFunctionLiteral(literal, env)
+	if err != nil {
+		return nil, err
+	}
+	env.Set(function.Name, function)
+	return function, nil
+}
+
+// evalExpressions evaluates expressions from left to right, stopping at the
+// first error. Spread expressions put the elements of their array in the
+// results one by one.
+func evalExpressions(exprs []ast.IExpr, env *object.Environment) ([]object.Object, error) {
+	var results []object.Object
+	for _, e := range exprs {
+		if spread, ok := e.(ast.SpreadExpression); ok {
+			elements, err := evalSpread(spread, env)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, elements...)
+			continue
+		}
+		result, err := evalValue(e, env)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func evalSpread(spread ast.SpreadExpression, env *object.Environment) ([]object.Object, error) {
+	value, err := evalValue(spread.Value, env)
+	if err != nil {
+		return nil, err
+	}
+	array, ok := value.(*object.Array)
+	if !ok {
+		return nil, object.NewError("can not spread %s, only arrays", value.Type())
+	}
+	return array.Elements, nil
+}
+
+type namedArgument struct {
+	name  string
+	value object.Object
+}
+
+// evalArguments evaluates the arguments of a call from left to right and
+// sorts them into positional and named ones.
+func evalArguments(exprs []ast.IExpr, env *object.Environment) ([]object.Object, []namedArgument, error) {
+	var positional []object.Object
+	var named []namedArgument
+	for _, e := range exprs {
+		switch argument := e.(type) {
+		case ast.NamedArgument:
+			value, err := evalValue(argument.Value, env)
+			if err != nil {
+				return nil, nil, err
+			}
+			named = append(named, namedArgument{name: argument.Name.Value, value: value})
+		case ast.SpreadExpression:
+			elements, err := evalSpread(argument, env)
+			if err != nil {
+				return nil, nil, err
+			}
+			positional = append(positional, elements...)
+		default:
+			value, err := evalValue(argument, env)
+			if err != nil {
+				return nil, nil, err
+			}
+			positional = append(positional, value)
+		}
+	}
+	return positional, named, nil
+}
+
+func evalCallExpression(call ast.CallExpression, env *object.Environment) (object.Object, error) {
+	tailCall, err := evalTailCall(call, env)
+	if err != nil {
+		return nil, err
+	}
+	return runCall(tailCall)
+}
+
+// evalTailCall evaluates the callee and the arguments of call and binds
+// them, but leaves running the body to runCall.
+func evalTailCall(call ast.CallExpression, env *object.Environment) (*object.TailCall, error) {
+	callee, err := evalValue(call.Function, env)
+	if err != nil {
+		return nil, err
+	}
+	function, ok := callee.(*object.Function)
+	if !ok {
+		return nil, object.NewError("not a function: %s", callee.Type())
+	}
+
+	positional, named, err := evalArguments(call.Parameters, env)
+	if err != nil {
+		return nil, err
+	}
+	inner, err := bindArguments(function, positional, named)
+	if err != nil {
+		return nil, err
+	}
+	return &object.TailCall{Function: function, Env: inner}, nil
+}
+
+// runCall runs the body of a bound call. A body that ends in another call
+// hands that back instead of making it, and runCall goes on with it in the
+// same loop: this trampoline keeps tail calls from growing the Go stack.
+func runCall(call *object.TailCall) (object.Object, error) {
+	for {
+		result, err := evalTail(call.Function.Body, call.Env)
+		if err != nil {
+			return nil, err
+		}
+		if r, ok := result.(*object.ReturnValue); ok {
+			result = r.Value
+		}
+		next, ok := result.(*object.TailCall)
+		if !ok {
+			return result, nil
+		}
+		call = next
+	}
+}
+
+// bindArguments makes the scope a call of function runs in. Positional
+// arguments fill the parameters in order and whatever is left over goes to
+// the rest parameter. Named arguments fill the parameters of their name.
+// Parameters still unfilled take their defaults, which are evaluated in the
+// new scope, so that they can refer to the parameters before them.
+func bindArguments(function *object.Function, positional []object.Object, named []namedArgument) (*object.Environment, error) {
+	inner := object.NewEnclosedEnvironment(function.Env)
+	given := len(positional) + len(named)
+	// bound[i] tells whether function.Parameters[i] has a value
+	bound := make([]bool, len(function.Parameters))
+	for i, p := range function.Parameters {
+		switch {
+		case p.Rest:
+			rest := make([]object.Object, len(positional))
+			copy(rest, positional)
+			inner.Set(p.Name.Value, &object.Array{Elements: rest})
+			positional = nil
+		case len(positional) > 0:
+			inner.Set(p.Name.Value, positional[0])
+			positional = positional[1:]
+		default:
+			continue
+		}
+		bound[i] = true
+	}
+	if len(positional) > 0 {
+		return nil, arityError(function, given)
+	}
+
+	for _, argument := range named {
+		i, ok := findParameter(function, argument.name)
+		if !ok {
+			return nil, object.NewError("%s has no parameter %s", functionName(function), argument.name)
+		}
+		if bound[i] {
+			return nil, object.NewError(
+				"%s got two values for parameter %s", functionName(function), argument.name,
+			)
+		}
+		inner.Set(argument.name, argument.value)
+		bound[i] = true
+	}
+
+	for i, p := range function.Parameters {
+		if bound[i] {
+			continue
+		}
+		if p.Default == nil {
+			return nil, arityError(function, given)
+		}
+		value, err := evalValue(p.Default, inner)
+		if err != nil {
+			return nil, err
+		}
+		inner.Set(p.Name.Value, value)
+	}
+	return inner, nil
+}
+
+// findParameter looks up the index of a parameter that can be passed by
+// name, which is any but the rest parameter.
+func findParameter(function *object.Function, name string) (int, bool) {
+	for i, p := range function.Parameters {
+		if p.Name.Value == name && !p.Rest {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// arityError tells how many arguments function takes: an exact count, a
+// range when some parameters have defaults or a minimum when there is a
+// rest parameter.
+func arityError(function *object.Function, got int) error {
+	required, total, rest := 0, 0, false
+	for _, p := range function.Parameters {
+		switch {
+		case p.Rest:
+			rest = true
+		case p.Default == nil:
+			required++
+			total++
+		default:
+			total++
+		}
+	}
+	want := strconv.Itoa(required)
+	switch {
+	case rest:
+		want = fmt.Sprintf("at least %d", required)
+	case required != total:
+		want = fmt.Sprintf("%d to %d", required, total)
+	}
+	return object.NewError(
+		"wrong number of arguments to %s: want %s, got %d",
+		functionName(function), want, got,
+	)
+}
+
+func functionName(function *object.Function) string {
+	if function.Name == "" {
+		return "anonymous function"
+	}
+	return function.Name
+}
@@ -0,0 +1,139 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"testing"
+)
+
+func Test_evalAnonymousFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let add = fun(a, b) { a + b }; add(2, 3)", 5},
+		{"fun(x) { x }(5)", 5},
+		{"fun(a, b) { a * b }(6, 7)", 42},
+		{"fun apply(f, x) { f(x) } apply(fun(x) { x * 2 }, 21)", 42},
+		{"let compose = fun(f, g) { fun(x) { f(g(x)) } }; compose(fun(x) { x + 1 }, fun(x) { x * 10 })(4)", 41},
+		{"let adder = fun(x) { fun(y) { x + y } }; adder(1)(2)", 3},
+		{"[fun(x) { x + 1 }, fun(x) { x + 2 }][1](1)", 3},
+		{`{"double": fun(x) { x * 2 }}["double"](8)`, 16},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalNamedFunctionExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let f = fun fact(n) { if (n < 2) { 1 } else { n * fact(n - 1) } }; f(5)", 120},
+		{"(fun fact(n) { if (n < 2) { 1 } else { n * fact(n - 1) } })(6)", 720},
+		{"fun apply(f, x) { f(x) } apply(fun sum(n) { if (n == 0) { 0 } else { n + sum(n - 1) } }, 10)", 55},
+		// the recursion does not go through the name outside the function
+		{"fun count(n) { if (n == 0) { 0 } else { 1 + count(n - 1) } } let c = count; count = 0; c(3)", 3},
+		{"let fact = 7; let f = fun fact(n) { if (n < 2) { 1 } else { n * fact(n - 1) } }; f(3) + fact", 13},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalFunctionDeclarations(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		// a declaration ends with its body, the next line is not a call
+		{"fun f(x) { x }\n(2 + 3); f(1)", 1},
+		{"fun f(x) { x }\n[1, 2]; f(2)", 2},
+		{"fun f(x) { x } (f(3))", 3},
+		// anonymous functions can still be called where they are written
+		{"fun(x) { x }(4)", 4},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalFunctionNamesStayInside(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let f = fun g() { 1 }; g()", "identifier not found: g"},
+		{"(fun g(x) { x })(1); g", "identifier not found: g"},
+		{"fun apply(f) { f() } apply(fun inner() { 1 }); inner", "identifier not found: inner"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
+
+func Test_inspectFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun(x) { x }", "fun(x) {\n  x\n}"},
+		{"fun id(x) { x }", "fun id(x) {\n  x\n}"},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		function, ok := evaluated.(*object.Function)
+		if !ok {
+			t.Fatalf("%q: object is not Function. got=%T", tt.input, evaluated)
+		}
+		if function.Inspect() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, function.Inspect())
+		}
+	}
+}
+
+func Test_evalArrowFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let double = x => x * 2; double(21)", 42},
+		{"let add = (a, b) => a + b; add(2, 3)", 5},
+		{"let answer = () => 42; answer()", 42},
+		{"(x => x * 2)(5)", 10},
+		{"let add = x => y => x + y; add(1)(2)", 3},
+		{"fun apply(f, x) { f(x) } apply(x => x - 1, 10)", 9},
+		{"let sum = (a, b) => { let c = a + b; return c * 2; 0 }; sum(1, 2)", 6},
+		{`let wrap = x => {"value": x}; wrap(7)["value"]`, 7},
+		{"let n = 10; let addN = x => x + n; n = 20; addN(1)", 21},
+		{"let total = 0; for x in [1, 2, 3] { let f = y => total += y; f(x) }; total", 6},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
@@ -0,0 +1,38 @@
+package evaluator
+
+import (
+	"interpreter/ast"
+	"interpreter/object"
+)
+
+func evalHashLiteral(literal ast.HashLiteral, env *object.Environment) (object.Object, error) {
+	hash := object.NewHash()
+	for _, pair := range literal.Pairs {
+		key, err := evalValue(pair.Key, env)
+		if err != nil {
+			return nil, err
+		}
+		hashable, ok := key.(object.Hashable)
+		if !ok {
+			return nil, object.NewError("unusable as hash key: %s", key.Type())
+		}
+		value, err := evalValue(pair.Value, env)
+		if err != nil {
+			return nil, err
+		}
+		hash.Set(hashable, value)
+	}
+	return hash, nil
+}
+
+// evalHashIndexExpression yields null for keys missing from the hash.
+func evalHashIndexExpression(hash *object.Hash, index object.Object) (object.Object, error) {
+	key, ok := index.(object.Hashable)
+	if !ok {
+		return nil, object.NewError("unusable as hash key: %s", index.Type())
+	}
+	if value, ok := hash.Get(key); ok {
+		return value, nil
+	}
+	return object.NULL, nil
+}
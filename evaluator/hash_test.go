@@ -0,0 +1,121 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"strings"
+	"testing"
+)
+
+func Test_evalHashLiteral(t *testing.T) {
+	input := `let two = "two";
+{
+	"one": 10 - 9,
+	two: 1 + 1,
+	"thr" + "ee": 6 / 2,
+	4: 4,
+	true: 5,
+	false: 6
+}`
+	evaluated, err := testEval(t, input)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	result, ok := evaluated.(*object.Hash)
+	if !ok {
+		t.Fatalf("Eval didn't return Hash. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	expected := map[object.HashKey]int{
+		(&object.String{Value: "one"}).HashKey():   1,
+		(&object.String{Value: "two"}).HashKey():   2,
+		(&object.String{Value: "three"}).HashKey(): 3,
+		(&object.Integer{Value: 4}).HashKey():      4,
+		object.TRUE.HashKey():                      5,
+		object.FALSE.HashKey():                     6,
+	}
+	if len(result.Pairs) != len(expected) {
+		t.Fatalf("Hash has wrong num of pairs. got=%d", len(result.Pairs))
+	}
+	for expectedKey, expectedValue := range expected {
+		pair, ok := result.Pairs[expectedKey]
+		if !ok {
+			t.Errorf("no pair for given key in Pairs")
+		}
+		testIntegerObject(t, pair.Value, expectedValue)
+	}
+
+	if result.Inspect() != "{one: 1, two: 2, three: 3, 4: 4, true: 5, false: 6}" {
+		t.Errorf("Inspect() wrong. got=%q", result.Inspect())
+	}
+}
+
+func Test_evalHashOfNoValues(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let x = if (true) { }; let h = {1: x}; h", "{1: null}"},
+		{`fun f() { } let h = {"a": f(), "b": if (false) { 1 }}; h`, "{a: null, b: null}"},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		hash, ok := evaluated.(*object.Hash)
+		if !ok {
+			t.Fatalf("%q: Eval didn't return Hash. got=%T (%+v)", tt.input, evaluated, evaluated)
+		}
+		for _, pair := range hash.Ordered() {
+			testNullObject(t, pair.Value)
+		}
+		if hash.Inspect() != tt.expected {
+			t.Errorf("%q: Inspect() wrong. expected=%q, got=%q", tt.input, tt.expected, hash.Inspect())
+		}
+	}
+}
+
+func Test_evalHashIndexExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`{"foo": 5}["foo"]`, 5},
+		{`{"foo": 5}["bar"]`, nil},
+		{`let key = "foo"; {"foo": 5}[key]`, 5},
+		{`({})["foo"]`, nil},
+		{`{5: 5}[5]`, 5},
+		{`{true: 5}[true]`, 5},
+		{`{false: 5}[1 > 2]`, 5},
+		{`{"a": 1, "a": 2}["a"]`, 2},
+		{`let h = {"inner": {"x": [1, 2]}}; h["inner"]["x"][1]`, 2},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		if integer, ok := tt.expected.(int); ok {
+			testIntegerObject(t, evaluated, integer)
+		} else {
+			testNullObject(t, evaluated)
+		}
+	}
+}
+
+func Test_evalHashErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{"name": "Monkey"}[fun f(x) { x }]`, "unusable as hash key: FUNCTION"},
+		{`let h = {[1]: 2}`, "unusable as hash key: ARRAY"},
+		{`let h = {{}: 2}`, "unusable as hash key: HASH"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%q: wrong error. expected=%q, got=%v", tt.input, tt.expected, err)
+		}
+	}
+}
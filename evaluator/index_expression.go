@@ -0,0 +1,43 @@
+package evaluator
+
+import (
+	"interpreter/ast"
+	"interpreter/object"
+)
+
+func evalIndexExpression(expr ast.IndexExpression, env *object.Environment) (object.Object, error) {
+	left, err := evalValue(expr.Left, env)
+	if err != nil {
+		return nil, err
+	}
+	index, err := evalValue(expr.Index, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case left.Type() == object.ARRAY_OBJ && index.Type() == object.INTEGER_OBJ:
+		{
+			small, ok := index.(*object.Integer)
+			if !ok {
+				// a big integer is out of range of any array
+				return object.NULL, nil
+			}
+			return evalArrayIndexExpression(left.(*object.Array), small.Value), nil
+		}
+	case left.Type() == object.ARRAY_OBJ:
+		return nil, object.NewError("array index must be INTEGER, got %s", index.Type())
+	case left.Type() == object.HASH_OBJ:
+		return evalHashIndexExpression(left.(*object.Hash), index)
+	}
+	return nil, object.NewError("index operator not supported: %s", left.Type())
+}
+
+// evalArrayIndexExpression yields null for indexes outside the array,
+// negative ones included.
+func evalArrayIndexExpression(array *object.Array, index int) object.Object {
+	if index < 0 || index >= len(array.Elements) {
+		return object.NULL
+	}
+	return array.Elements[index]
+}
@@ -0,0 +1,157 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"interpreter/token"
+	"math/big"
+)
+
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// evalIntegerInfixExpression does integer arithmetic on ints as long as the
+// result fits, and redoes it on big.Ints when it does not.
+func evalIntegerInfixExpression(operator token.Token, left, right int) (object.Object, error) {
+	switch operator.Class {
+	case token.PLUS:
+		{
+			sum := left + right
+			if (sum > left) == (right > 0) {
+				return &object.Integer{Value: sum}, nil
+			}
+		}
+	case token.MINUS:
+		{
+			difference := left - right
+			if (difference < left) == (right > 0) {
+				return &object.Integer{Value: difference}, nil
+			}
+		}
+	case token.ASTERISK:
+		{
+			product := left * right
+			if left == 0 || product/left == right && !(left == -1 && right == minInt) {
+				return &object.Integer{Value: product}, nil
+			}
+		}
+	case token.SLASH:
+		{
+			if right == 0 {
+				return nil, object.NewError("division by zero: %d / %d", left, right)
+			}
+			if !(left == minInt && right == -1) {
+				return &object.Integer{Value: left / right}, nil
+			}
+		}
+	case token.PERCENT:
+		{
+			if right == 0 {
+				return nil, object.NewError("modulo by zero: %d %% %d", left, right)
+			}
+			// cannot overflow, minInt % -1 == 0
+			return &object.Integer{Value: left % right}, nil
+		}
+	case token.POWER:
+		// done on big.Ints below
+	case token.LT:
+		return object.NativeBoolToBooleanObject(left < right), nil
+	case token.GT:
+		return object.NativeBoolToBooleanObject(left > right), nil
+	case token.LTE:
+		return object.NativeBoolToBooleanObject(left <= right), nil
+	case token.GTE:
+		return object.NativeBoolToBooleanObject(left >= right), nil
+	case token.EQUAL:
+		return object.NativeBoolToBooleanObject(left == right), nil
+	case token.UNEQUAL:
+		return object.NativeBoolToBooleanObject(left != right), nil
+	default:
+		return nil, object.NewError("unknown operator: INTEGER %s INTEGER", operator.Literal)
+	}
+	return evalBigIntegerInfixExpression(operator, big.NewInt(int64(left)), big.NewInt(int64(right)))
+}
+
+// evalBigIntegerInfixExpression is evalIntegerInfixExpression for operands of
+// any size. Results that fit in an int come back as plain Integers.
+func evalBigIntegerInfixExpression(operator token.Token, left, right *big.Int) (object.Object, error) {
+	switch operator.Class {
+	case token.PLUS:
+		return object.NewInteger(new(big.Int).Add(left, right)), nil
+	case token.MINUS:
+		return object.NewInteger(new(big.Int).Sub(left, right)), nil
+	case token.ASTERISK:
+		return object.NewInteger(new(big.Int).Mul(left, right)), nil
+	case token.SLASH:
+		{
+			if right.Sign() == 0 {
+				return nil, object.NewError("division by zero: %s / %s", left, right)
+			}
+			// Quo truncates towards zero like Go's / on ints
+			return object.NewInteger(new(big.Int).Quo(left, right)), nil
+		}
+	case token.PERCENT:
+		{
+			if right.Sign() == 0 {
+				return nil, object.NewError("modulo by zero: %s %% %s", left, right)
+			}
+			// Rem takes the sign of left like Go's % on ints
+			return object.NewInteger(new(big.Int).Rem(left, right)), nil
+		}
+	case token.POWER:
+		return evalIntegerPower(left, right)
+	case token.LT:
+		return object.NativeBoolToBooleanObject(left.Cmp(right) < 0), nil
+	case token.GT:
+		return object.NativeBoolToBooleanObject(left.Cmp(right) > 0), nil
+	case token.LTE:
+		return object.NativeBoolToBooleanObject(left.Cmp(right) <= 0), nil
+	case token.GTE:
+		return object.NativeBoolToBooleanObject(left.Cmp(right) >= 0), nil
+	case token.EQUAL:
+		return object.NativeBoolToBooleanObject(left.Cmp(right) == 0), nil
+	case token.UNEQUAL:
+		return object.NativeBoolToBooleanObject(left.Cmp(right) != 0), nil
+	}
+	return nil, object.NewError("unknown operator: INTEGER %s INTEGER", operator.Literal)
+}
+
+// maxPowerBits bounds the size of an integer power, so that a typo like
+// 10 ** 10 ** 10 fails instead of eating all memory.
+const maxPowerBits = 1 << 24
+
+// evalIntegerPower raises left to right exactly when right is not negative.
+// A negative exponent gives a float, as in 2 ** -1 == 0.5, and 0 to a
+// negative power is a division by zero.
+func evalIntegerPower(left, right *big.Int) (object.Object, error) {
+	one := big.NewInt(1)
+	switch {
+	case right.Sign() < 0:
+		{
+			if left.Sign() == 0 {
+				return nil, object.NewError("division by zero: %s ** %s", left, right)
+			}
+			return evalFloatPower(toFloat(object.NewInteger(left)), toFloat(object.NewInteger(right)))
+		}
+	case right.Sign() == 0:
+		return &object.Integer{Value: 1}, nil
+	case left.Sign() == 0:
+		return &object.Integer{Value: 0}, nil
+	case left.CmpAbs(one) == 0:
+		{
+			// 1 and -1 stay small whatever the exponent
+			if left.Sign() < 0 && right.Bit(0) == 1 {
+				return &object.Integer{Value: -1}, nil
+			}
+			return &object.Integer{Value: 1}, nil
+		}
+	}
+	// the result has at least right * (bits of left - 1) bits
+	if !right.IsInt64() || right.Int64() > maxPowerBits/int64(new(big.Int).Abs(left).BitLen()-1) {
+		return nil, object.NewError(
+			"integer overflow: %s ** %s has more than %d bits", left, right, maxPowerBits,
+		)
+	}
+	return object.NewInteger(new(big.Int).Exp(left, right, nil)), nil
+}
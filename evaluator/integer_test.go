@@ -0,0 +1,102 @@
+package evaluator
+
+import (
+	"interpreter/object"
+	"testing"
+)
+
+func Test_evalIntegersPromoteToBig(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"9223372036854775807 + 1", "9223372036854775808"},
+		{"-9223372036854775807 - 2", "-9223372036854775809"},
+		{"4294967296 * 4294967296", "18446744073709551616"},
+		{"-(-9223372036854775807 - 1)", "9223372036854775808"},
+		{"(-9223372036854775807 - 1) / -1", "9223372036854775808"},
+		{"123456789012345678901234567890 * 1000", "123456789012345678901234567890000"},
+		{"99999999999999999999 / 3", "33333333333333333333"},
+		{"-99999999999999999999 / 7", "-14285714285714285714"},
+		{"let f = fun fact(n) { if (n < 2) { 1 } else { n * fact(n - 1) } }; f(25)",
+			"15511210043330985984000000"},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		if _, ok := evaluated.(*object.BigInteger); !ok {
+			t.Errorf("%q: object is not BigInteger. got=%T (%+v)", tt.input, evaluated, evaluated)
+		}
+		if evaluated.Type() != object.INTEGER_OBJ {
+			t.Errorf("%q: type wrong. expected=%s, got=%s", tt.input, object.INTEGER_OBJ, evaluated.Type())
+		}
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%q: Inspect() wrong. expected=%s, got=%s", tt.input, tt.expected, evaluated.Inspect())
+		}
+	}
+}
+
+func Test_evalBigIntegersShrinkBack(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"9223372036854775808 - 1", 9223372036854775807},
+		{"(9223372036854775807 + 10) - 20", 9223372036854775797},
+		{"18446744073709551616 / 4294967296", 4294967296},
+		{"-9223372036854775808", -9223372036854775808},
+		{"9223372036854775807 * -1", -9223372036854775807},
+		{"(-9223372036854775807 - 1) * 1", -9223372036854775808},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalBigIntegerComparisons(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"9223372036854775808 > 9223372036854775807", true},
+		{"1 < 99999999999999999999", true},
+		{"99999999999999999999 == 99999999999999999999", true},
+		{"99999999999999999999 != 99999999999999999998 + 1", false},
+		{"9223372036854775807 + 1 == 9223372036854775808", true},
+		{"9223372036854775808 - 1 == 9223372036854775807", true},
+		{"99999999999999999999 > 1.5", true},
+		{"{99999999999999999999: true}[99999999999999999998 + 1]", true},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalBigIntegerErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"99999999999999999999 / 0", "division by zero: 99999999999999999999 / 0"},
+		{"99999999999999999999 + true", "type mismatch: INTEGER + BOOLEAN"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package evaluator
+
+import (
+	"interpreter/ast"
+	"interpreter/object"
+	"interpreter/token"
+)
+
+func isLogicalOperator(operator token.Token) bool {
+	return operator.Class == token.LOGICAND || operator.Class == token.LOGICOR
+}
+
+// evalLogicalExpression short-circuits: the right operand of `&&` is only
+// evaluated when the left one is truthy, and that of `||` only when the left
+// one is falsy. The result is always a boolean.
+func evalLogicalExpression(expr *ast.InfixExpression, env *object.Environment) (object.Object, error) {
+	left, err := evalValue(expr.Left, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case expr.Operator.Class == token.LOGICAND && !isTruthy(left):
+		return object.FALSE, nil
+	case expr.Operator.Class == token.LOGICOR && isTruthy(left):
+		return object.TRUE, nil
+	}
+
+	right, err := evalValue(expr.Right, env)
+	if err != nil {
+		return nil, err
+	}
+	return object.NativeBoolToBooleanObject(isTruthy(right)), nil
+}
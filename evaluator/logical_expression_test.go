@@ -0,0 +1,50 @@
+package evaluator
+
+import "testing"
+
+func Test_evalLogicalExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"true && true", true},
+		{"true && false", false},
+		{"false && true", false},
+		{"false || true", true},
+		{"false || false", false},
+		{"1 < 2 && 2 < 3", true},
+		{"1 > 2 || 2 > 3", false},
+		{"1 && 0", true},
+		{"if (false) { 1 } || false", false},
+		{"true || false && false", true},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalLogicalExpressionShortCircuits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"fun crash() { 1 + true } false && crash()", false},
+		{"fun crash() { 1 + true } true || crash()", true},
+		{"fun crash() { 1 + true } false && crash() || true", true},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: right operand was evaluated: %v", tt.input, err)
+		}
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+
+	if _, err := testEval(t, "fun crash() { 1 + true } true && crash()"); err == nil {
+		t.Fatalf("right operand of && was not evaluated")
+	}
+}
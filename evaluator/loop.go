@@ -0,0 +1,120 @@
+package evaluator
+
+import (
+	"interpreter/ast"
+	"interpreter/object"
+)
+
+// evalWhileExpression evaluates to null, like all loops, unless a return
+// statement leaves it.
+func evalWhileExpression(expr ast.WhileExpression, env *object.Environment) (object.Object, error) {
+	for {
+		condition, err := evalValue(expr.Condition, env)
+		if err != nil {
+			return nil, err
+		}
+		if !isTruthy(condition) {
+			return object.NULL, nil
+		}
+		if result, done, err := evalLoopBody(expr.Body, env); done {
+			return result, err
+		}
+	}
+}
+
+// evalForExpression runs the init clause in a scope of its own, so that the
+// loop variable is gone after the loop.
+func evalForExpression(expr ast.ForExpression, env *object.Environment) (object.Object, error) {
+	scope := object.NewEnclosedEnvironment(env)
+	if expr.Init != nil {
+		if _, err := Eval(expr.Init, scope); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		if expr.Condition != nil {
+			condition, err := evalValue(expr.Condition, scope)
+			if err != nil {
+				return nil, err
+			}
+			if !isTruthy(condition) {
+				return object.NULL, nil
+			}
+		}
+		if result, done, err := evalLoopBody(expr.Body, scope); done {
+			return result, err
+		}
+		if expr.Update != nil {
+			if _, err := evalValue(expr.Update, scope); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// evalForInExpression binds the variable in a new scope for every element,
+// so closures made in the body each see their own element.
+func evalForInExpression(expr ast.ForInExpression, env *object.Environment) (object.Object, error) {
+	iterable, err := evalValue(expr.Iterable, env)
+	if err != nil {
+		return nil, err
+	}
+	elements, err := elementsOf(iterable)
+	if err != nil {
+		return nil, err
+	}
+	for _, element := range elements {
+		scope := object.NewEnclosedEnvironment(env)
+		scope.Set(expr.Variable.Value, element)
+		if result, done, err := evalLoopBody(expr.Body, scope); done {
+			return result, err
+		}
+	}
+	return object.NULL, nil
+}
+
+// evalLoopBody runs one iteration of a loop. done is set when the loop has
+// to stop, either on an error or with result: null after a break, the
+// wrapped value after a return.
+func evalLoopBody(body *ast.BlockStatement, env *object.Environment) (result object.Object, done bool, err error) {
+	result, err = Eval(*body, env)
+	if err != nil {
+		return nil, true, err
+	}
+	switch result.(type) {
+	case *object.Break:
+		return object.NULL, true, nil
+	case *object.ReturnValue:
+		return result, true, nil
+	}
+	return nil, false, nil
+}
+
+// elementsOf lists what a for-in loop goes through: the elements of an
+// array, the characters of a string or the keys of a hash in insertion
+// order.
+func elementsOf(iterable object.Object) ([]object.Object, error) {
+	switch iterable := iterable.(type) {
+	case *object.Array:
+		{
+			return iterable.Elements, nil
+		}
+	case *object.String:
+		{
+			var characters []object.Object
+			for _, r := range iterable.Value {
+				characters = append(characters, &object.String{Value: string(r)})
+			}
+			return characters, nil
+		}
+	case *object.Hash:
+		{
+			var keys []object.Object
+			for _, pair := range iterable.Ordered() {
+				keys = append(keys, pair.Key)
+			}
+			return keys, nil
+		}
+	}
+	return nil, object.NewError("can not iterate over %s", iterable.Type())
+}
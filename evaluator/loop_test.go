@@ -0,0 +1,103 @@
+package evaluator
+
+import (
+	"testing"
+)
+
+func Test_evalLoops(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let i = 0; while (i < 10) { i += 1 }; i", 10},
+		{"let sum = 0; for (let i = 1; i <= 100; i += 1) { sum += i }; sum", 5050},
+		{"let sum = 0; let i = 0; for (; i < 5;) { sum += i; i += 1 }; sum", 10},
+		{"let n = 0; for (;;) { n += 1; if (n == 7) { break } }; n", 7},
+		{"let sum = 0; for x in [1, 2, 3, 4] { sum += x }; sum", 10},
+		{"let sum = 0; for (k in {1: 0, 2: 0, 3: 0}) { sum += k }; sum", 6},
+		{`let n = 0; for c in "héllo" { n += 1 }; n`, 5},
+		// continue still runs the update clause
+		{"let odd = 0; for (let i = 0; i < 10; i += 1) { if (i % 2 == 0) { continue } odd += 1 }; odd", 5},
+		{"let i = 0; let n = 0; while (i < 10) { i += 1; if (i > 3) { continue } n += i }; n", 6},
+		// break only leaves the innermost loop
+		{
+			"let n = 0; for (let i = 0; i < 3; i += 1) { for (let j = 0; j < 10; j += 1) { if (j == 2) { break } n += 1 } }; n",
+			6,
+		},
+		// a return leaves the loop and the function
+		{"fun find(xs) { for x in xs { if (x > 2) { return x } }; -1 } find([1, 5, 3])", 5},
+		{"fun find(xs) { for x in xs { if (x > 9) { return x } }; -1 } find([1, 5, 3])", -1},
+		{"fun count() { let i = 0; while (true) { i += 1; if (i == 4) { return i } } } count()", 4},
+		// a million iterations do not touch the Go stack
+		{"let i = 0; while (i < 1000000) { i += 1 }; i", 1000000},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalLoopsAreNull(t *testing.T) {
+	tests := []string{
+		"while (false) { 1 }",
+		"let i = 0; while (i < 3) { i += 1 }",
+		"for (;;) { break }",
+		"for x in [] { x }",
+		"for x in [1] { x }",
+	}
+	for _, input := range tests {
+		evaluated, err := testEval(t, input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", input, err)
+		}
+		testNullObject(t, evaluated)
+	}
+}
+
+func Test_evalLoopScoping(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"for (let i = 0; i < 3; i += 1) { }; i", "identifier not found: i"},
+		{"for x in [1, 2] { }; x", "identifier not found: x"},
+		{"while (true) { let inner = 1; break }; inner", "identifier not found: inner"},
+		{"for x in 5 { }", "can not iterate over INTEGER"},
+		{"while (y) { }", "identifier not found: y"},
+		{"for (let i = 0; i < 3; i += true) { }", "type mismatch: INTEGER + BOOLEAN"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
+
+func Test_evalLoopBodiesHaveFreshScopes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		// every for-in iteration binds its own variable
+		{"let f = 0; for x in [1, 2, 3] { if (x == 2) { f = fun get() { x } } }; f()", 2},
+		// a let in the body does not survive into the next iteration
+		{"let n = 0; let i = 0; while (i < 3) { let n = i * 10; i += 1 }; n", 0},
+		// the shadowing loop variable leaves the outer one alone
+		{"let x = 42; for x in [1, 2] { x += 1 }; x", 42},
+		{"let i = 42; for (let i = 0; i < 3; i += 1) { }; i", 42},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
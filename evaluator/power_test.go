@@ -0,0 +1,98 @@
+package evaluator
+
+import (
+	"testing"
+)
+
+func Test_evalIntegerPower(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"2 ** 10", 1024},
+		{"2 ** 3 ** 2", 512},
+		{"(2 ** 3) ** 2", 64},
+		{"-2 ** 2", -4},
+		{"(-2) ** 3", -8},
+		{"0 ** 0", 1},
+		{"0 ** 5", 0},
+		{"7 ** 0", 1},
+		{"1 ** 99999999999999999999", 1},
+		{"(-1) ** 99999999999999999999", -1},
+		{"(-1) ** 99999999999999999998", 1},
+		{"2 * 3 ** 2", 18},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalBigIntegerPower(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2 ** 64", "18446744073709551616"},
+		{"10 ** 30", "1000000000000000000000000000000"},
+		{"(-3) ** 41", "-36472996377170786403"},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%q: Inspect() wrong. expected=%s, got=%s", tt.input, tt.expected, evaluated.Inspect())
+		}
+	}
+}
+
+func Test_evalFloatPower(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"2 ** -1", 0.5},
+		{"2 ** -2 ** 2", 0.0625},
+		{"(-2) ** -1", -0.5},
+		{"4 ** 0.5", 2},
+		{"2.5 ** 2", 6.25},
+		{"(-8.0) ** 3", -512},
+		{"10 ** -3", 0.001},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testFloatObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalPowerErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0 ** -1", "division by zero: 0 ** -1"},
+		{"0.0 ** -2", "division by zero: 0 ** -2"},
+		{"(-8) ** 0.5", "no real result: -8 ** 0.5"},
+		{"10 ** 10 ** 10", "integer overflow: 10 ** 10000000000 has more than 16777216 bits"},
+		{"2 ** 99999999999999999999", "integer overflow: 2 ** 99999999999999999999 has more than 16777216 bits"},
+		{`"a" ** 2`, "type mismatch: STRING ** INTEGER"},
+		{`true ** false`, "unknown operator: BOOLEAN ** BOOLEAN"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package evaluator
+
+import (
+	"interpreter/ast"
+	"interpreter/object"
+)
+
+// evalTail evaluates node in tail position, where its value becomes the
+// value of the function around it: the last statement of the body, of
+// blocks and if branches in tail position, and the value of a return. A
+// call there is bound but not made; the resulting *object.TailCall is handed
+// back to the trampoline in runCall.
+func evalTail(node ast.Node, env *object.Environment) (object.Object, error) {
+	switch node := node.(type) {
+	case *ast.ExpressionStatement:
+		{
+			switch node.Expression.(type) {
+			case ast.CallExpression, ast.IfExpression:
+				return evalTail(node.Expression, env)
+			}
+		}
+	case ast.BlockStatement:
+		{
+			return evalBlockStatement(node, env, true)
+		}
+	case ast.IfExpression:
+		{
+			return evalIfExpression(node, env, true)
+		}
+	case ast.CallExpression:
+		{
+			call, err := evalTailCall(node, env)
+			if err != nil {
+				return nil, err
+			}
+			return call, nil
+		}
+	}
+	return Eval(node, env)
+}
@@ -0,0 +1,109 @@
+package evaluator
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+// limitStack makes the test crash instead of slowly eating memory if a tail
+// call does grow the Go stack.
+func limitStack(t *testing.T) {
+	previous := debug.SetMaxStack(8 << 20)
+	t.Cleanup(func() { debug.SetMaxStack(previous) })
+}
+
+func Test_evalTailCallsRunInConstantStack(t *testing.T) {
+	// far more calls than the limited stack would hold if each took a
+	// frame of its own
+	limitStack(t)
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"fun countdown(n) { if (n == 0) { 0 } else { countdown(n - 1) } } countdown(1000000)", 0},
+		{"fun countdown(n) { if (n == 0) { return 0; } return countdown(n - 1); } countdown(100000)", 0},
+		{"fun sum(n, acc) { if (n == 0) { return acc; } sum(n - 1, acc + n) } sum(1000000, 0)", 500000500000},
+		{`
+fun isEven(n) { if (n == 0) { true } else { isOdd(n - 1) } }
+fun isOdd(n) { if (n == 0) { false } else { isEven(n - 1) } }
+if (isEven(100001)) { 1 } else { 0 }`, 0},
+		{"let loop = (n, acc = 0) => if (n == 0) { acc } else { loop(n - 1, acc + 1) }; loop(100000)", 100000},
+		{"fun f(n) { while (true) { if (n == 0) { return 7; } return f(n - 1); } } f(100000)", 7},
+		{"fun f(n) { { let m = n - 1; if (m < 0) { 1 } else { f(m) } } } f(100000)", 1},
+		{"fun f(n) { if (n == 0) { 2 } else if (n % 2 == 0) { f(n - 1) } else { f(n - 1) } } f(100000)", 2},
+		{"fun f(n) { if (n == 0) { 3 } else { f(n - 1) } } return f(100000)", 3},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalCallsOutOfTailPosition(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"fun sum(n) { if (n == 0) { 0 } else { n + sum(n - 1) } } sum(100)", 5050},
+		{"fun f(n) { let x = g(n); x + 1 } fun g(n) { n * 2 } f(5)", 11},
+		{"fun f() { g(); 1 } fun g() { 2 } f()", 1},
+		{"fun f() { [g()][0] } fun g() { 3 } f()", 3},
+		{"fun id(x) { x } fun f() { id(id(4)) } f()", 4},
+		{"let counter = 0; fun tick() { counter += 1 } fun f() { tick(); tick() } f(); counter", 2},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+}
+
+func Test_evalTailCallErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun f(n) { if (n == 0) { 1 + true } else { f(n - 1) } } f(10)", "type mismatch: INTEGER + BOOLEAN"},
+		{"fun f(n) { g(n) } fun g(a, b) { a } f(1)", "wrong number of arguments to g: want 2, got 1"},
+		{"fun f() { return 5(1) } f()", "not a function: INTEGER"},
+	}
+	for _, tt := range tests {
+		_, err := testEval(t, tt.input)
+		if err == nil {
+			t.Fatalf("%q: no error returned", tt.input)
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("%q: wrong error. expected=%q, got=%q", tt.input, tt.expected, err.Error())
+		}
+	}
+}
+
+func Test_evalReturnOutOfTailPositionStillCalls(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let count = 0; fun g() { count += 1 } fun f() { let a = if (true) { return g() }; 5 } f(); count", 1},
+		{"let count = 0; fun g() { count += 1 } fun f() { let a = if (true) { return g() }; 5 } f()", 1},
+		{"let count = 0; fun g() { count += 10 } fun f() { [if (true) { return g() }]; 5 } f(); count", 10},
+		{"let count = 0; fun g() { count += 1 } fun f() { while (true) { let x = 1 + if (true) { return g() } } } f(); count", 1},
+		{"let count = 0; fun g() { count += 1 } let a = if (true) { return g() }; count", 1},
+	}
+	for _, tt := range tests {
+		evaluated, err := testEval(t, tt.input)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", tt.input, err)
+		}
+		testIntegerObject(t, evaluated, tt.expected)
+	}
+
+	input := "fun g() { 1 / 0 } fun f() { let a = if (true) { return g() }; 5 } f()"
+	if _, err := testEval(t, input); err == nil || err.Error() != "division by zero: 1 / 0" {
+		t.Errorf("%q: wrong error. expected=%q, got=%v", input, "division by zero: 1 / 0", err)
+	}
+}
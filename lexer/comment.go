@@ -0,0 +1,49 @@
+package lexer
+
+import (
+	"errors"
+	"strings"
+)
+
+func (lexer *Lexer) startsComment() bool {
+	rest := lexer.rest(2)
+	return strings.HasPrefix(rest, "//") || strings.HasPrefix(rest, "/*")
+}
+
+// eatComment reads a `// ...` comment up to the end of its line, or a
+// `/* ... */` comment which may contain nested block comments. The returned
+// text includes the delimiters.
+func (lexer *Lexer) eatComment() (string, error) {
+	start := lexer.position
+	if strings.HasPrefix(lexer.rest(2), "//") {
+		for lexer.more() && lexer.input[lexer.position] != '\n' {
+			lexer.advance()
+		}
+		return lexer.input[start:lexer.position], nil
+	}
+
+	depth := 0
+	for lexer.more() {
+		rest := lexer.rest(2)
+		switch {
+		case strings.HasPrefix(rest, "/*"):
+			{
+				depth += 1
+				lexer.advance()
+				lexer.advance()
+			}
+		case strings.HasPrefix(rest, "*/"):
+			{
+				depth -= 1
+				lexer.advance()
+				lexer.advance()
+				if depth == 0 {
+					return lexer.input[start:lexer.position], nil
+				}
+			}
+		default:
+			lexer.advance()
+		}
+	}
+	return lexer.input[start:lexer.position], errors.New("unterminated block comment")
+}
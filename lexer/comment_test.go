@@ -0,0 +1,97 @@
+package lexer
+
+import (
+	"interpreter/token"
+	"strings"
+	"testing"
+)
+
+func TestLexer_NextToken_ShouldSkipComments(t *testing.T) {
+	input := `// leading comment
+let x = 10 / 2; // trailing comment
+/* block /* nested */ still comment */ x/*inline*/+ 1
+// comment at EOF`
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.LET, "let"},
+		{token.IDENT, "x"},
+		{token.ASSIGN, "="},
+		{token.INT, "10"},
+		{token.SLASH, "/"},
+		{token.INT, "2"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.PLUS, "+"},
+		{token.INT, "1"},
+		{token.EOF, "EOF"},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, err := lexer.NextToken()
+		if err != nil {
+			t.Fatalf("tests[%d] - unexpected error %v", i, err)
+		}
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldEmitComments(t *testing.T) {
+	input := "x // note\n/* a /* b */ c */ y"
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+		expectedSpan    string
+	}{
+		{token.IDENT, "x", "1:1-1:2"},
+		{token.COMMENT, "// note", "1:3-1:10"},
+		{token.COMMENT, "/* a /* b */ c */", "2:1-2:18"},
+		{token.IDENT, "y", "2:19-2:20"},
+		{token.EOF, "EOF", "2:20-2:20"},
+	}
+	lexer := New(input)
+	lexer.EmitComments = true
+	for i, tt := range tests {
+		tok, err := lexer.NextToken()
+		if err != nil {
+			t.Fatalf("tests[%d] - unexpected error %v", i, err)
+		}
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+		if tok.Span.String() != tt.expectedSpan {
+			t.Fatalf("tests[%d] - span wrong. expected=%s, got=%v",
+				i, tt.expectedSpan, tok.Span)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldReportUnterminatedComments(t *testing.T) {
+	for _, emit := range []bool{false, true} {
+		lexer := New("1 /* open /* nested */")
+		lexer.EmitComments = emit
+		_, _ = lexer.NextToken()
+		tok, err := lexer.NextToken()
+		if err == nil || !strings.Contains(err.Error(), "unterminated block comment") {
+			t.Fatalf("EmitComments=%t: wrong error. got=%v", emit, err)
+		}
+		if tok.Class != token.ILLEGAL {
+			t.Fatalf("EmitComments=%t: tokentype wrong. got=%q", emit, tok.Class)
+		}
+	}
+}
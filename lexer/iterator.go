@@ -0,0 +1,37 @@
+package lexer
+
+import "interpreter/token"
+
+// TokenIterator walks the tokens of a lexer in the manner of bufio.Scanner.
+type TokenIterator struct {
+	lexer *Lexer
+	token token.Token
+	err   error
+}
+
+// Tokens returns an iterator over the tokens left in the input. It stops
+// before EOF and keeps going past illegal tokens, whose errors are reported
+// by Err:
+//
+//	for it := lex.Tokens(); it.Next(); {
+//		fmt.Println(it.Token(), it.Err())
+//	}
+func (lexer *Lexer) Tokens() *TokenIterator {
+	return &TokenIterator{lexer: lexer}
+}
+
+// Next reads the next token and reports whether there was one before EOF.
+func (it *TokenIterator) Next() bool {
+	it.token, it.err = it.lexer.NextToken()
+	return it.token.Class != token.EOF
+}
+
+// Token returns the token read by the last call to Next.
+func (it *TokenIterator) Token() token.Token {
+	return it.token
+}
+
+// Err returns the error of the token read by the last call to Next.
+func (it *TokenIterator) Err() error {
+	return it.err
+}
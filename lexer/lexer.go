@@ -4,57 +4,121 @@ import (
 	"errors"
 	"fmt"
 	"interpreter/token"
-	"strings"
+	"io"
+	"unicode"
+	"unicode/utf8"
 )
 
 type Lexer struct {
+	// EmitComments makes NextToken return comments as COMMENT tokens
+	// instead of skipping them like blank space.
+	EmitComments bool
+
+	// input buffers the source from base on; a lexer made by NewReader
+	// appends to it from reader and drops what it has read between tokens
 	input    string
 	position int
+	base     int
+	reader   io.Reader
+	chunk    []byte
+	readErr  error
+	// peeked holds the tokens Peek has read ahead
+	peeked []lexed
+	// line counts the newlines before position, lineStart is the position
+	// right after the last one
+	line      int
+	lineStart int
+	// runeColumn counts the runes between lineStart and position
+	runeColumn int
+	// operators is nil until AddOperator is called, defaultOperators is used
+	// in the meantime
+	operators *operatorTable
 }
 
 var dictAtom = map[string]token.Token{
-	"=": token.New(token.ASSIGN, "="),
-	"+": token.New(token.PLUS, "+"),
-	"(": token.New(token.LPAREN, "("),
-	")": token.New(token.RPAREN, ")"),
-	"{": token.New(token.LBRACE, "{"),
-	"}": token.New(token.RBRACE, "}"),
-	",": token.New(token.COMMA, ","),
-	"-": token.New(token.MINUS, "-"),
-	"!": token.New(token.BANG, "!"),
-	"/": token.New(token.SLASH, "/"),
-	">": token.New(token.GT, ">"),
-	"<": token.New(token.LT, "<"),
-	"*": token.New(token.ASTERISK, "*"),
-	";": token.New(token.SEMICOLON, ";"),
+	"=":  token.New(token.ASSIGN, "="),
+	"+":  token.New(token.PLUS, "+"),
+	"(":  token.New(token.LPAREN, "("),
+	")":  token.New(token.RPAREN, ")"),
+	"{":  token.New(token.LBRACE, "{"),
+	"}":  token.New(token.RBRACE, "}"),
+	"[":  token.New(token.LBRACKET, "["),
+	"]":  token.New(token.RBRACKET, "]"),
+	",":  token.New(token.COMMA, ","),
+	":":  token.New(token.COLON, ":"),
+	"-":  token.New(token.MINUS, "-"),
+	"!":  token.New(token.BANG, "!"),
+	"/":  token.New(token.SLASH, "/"),
+	">":  token.New(token.GT, ">"),
+	"<":  token.New(token.LT, "<"),
+	">=": token.New(token.GTE, ">="),
+	"<=": token.New(token.LTE, "<="),
+	"%":  token.New(token.PERCENT, "%"),
+	"*":  token.New(token.ASTERISK, "*"),
+	"**": token.New(token.POWER, "**"),
+	";":  token.New(token.SEMICOLON, ";"),
+	"!=": token.New(token.UNEQUAL, "!="),
+	"==": token.New(token.EQUAL, "=="),
+	"&&": token.New(token.LOGICAND, "&&"),
+	"||": token.New(token.LOGICOR, "||"),
+
+	"+=":  token.New(token.PLUS_ASSIGN, "+="),
+	"-=":  token.New(token.MINUS_ASSIGN, "-="),
+	"*=":  token.New(token.ASTERISK_ASSIGN, "*="),
+	"/=":  token.New(token.SLASH_ASSIGN, "/="),
+	"%=":  token.New(token.PERCENT_ASSIGN, "%="),
+	"**=": token.New(token.POWER_ASSIGN, "**="),
+	"=>":  token.New(token.ARROW, "=>"),
+	"...": token.New(token.ELLIPSIS, "..."),
 }
 
 var dictKeyword = map[string]token.Token{
-	"let":    token.New(token.LET, "let"),
-	"fun":    token.New(token.FUNCTION, "fun"),
-	"true":   token.New(token.TRUE, "true"),
-	"false":  token.New(token.FALSE, "false"),
-	"if":     token.New(token.IF, "if"),
-	"else":   token.New(token.ELSE, "else"),
-	"return": token.New(token.RETURN, "return"),
-	"!=":     token.New(token.UNEQUAL, "!="),
-	"==":     token.New(token.EQUAL, "=="),
-	"&&":     token.New(token.LOGICAND, "&&"),
-	"||":     token.New(token.LOGICOR, "||"),
+	"let":      token.New(token.LET, "let"),
+	"fun":      token.New(token.FUNCTION, "fun"),
+	"true":     token.New(token.TRUE, "true"),
+	"false":    token.New(token.FALSE, "false"),
+	"if":       token.New(token.IF, "if"),
+	"else":     token.New(token.ELSE, "else"),
+	"return":   token.New(token.RETURN, "return"),
+	"while":    token.New(token.WHILE, "while"),
+	"for":      token.New(token.FOR, "for"),
+	"in":       token.New(token.IN, "in"),
+	"break":    token.New(token.BREAK, "break"),
+	"continue": token.New(token.CONTINUE, "continue"),
 }
 
 func (lexer *Lexer) eatBlankSpace() {
-	if lexer.position >= len(lexer.input) {
+	for lexer.more() {
+		if !isBlank(lexer.input[lexer.position]) {
+			return
+		}
+		lexer.advance()
+	}
+}
+
+// advance moves past the rune under the reading head.
+func (lexer *Lexer) advance() {
+	rest := lexer.rest(utf8.UTFMax)
+	if rest == "" {
 		return
 	}
+	_, width := utf8.DecodeRuneInString(rest)
+	if rest[0] == '\n' {
+		lexer.line += 1
+		lexer.lineStart = lexer.position + 1
+		lexer.runeColumn = 0
+	} else {
+		lexer.runeColumn += 1
+	}
+	lexer.position += width
+}
 
-	var ch byte
-	for lexer.position < len(lexer.input) {
-		ch = lexer.input[lexer.position]
-		if !(ch == ' ' || ch == '\n' || ch == '\t' || ch == '\r') {
-			break
-		}
-		lexer.position += 1
+func (lexer *Lexer) currentPosition() token.Position {
+	return token.Position{
+		Offset:     lexer.base + lexer.position,
+		Line:       lexer.line + 1,
+		Column:     lexer.runeColumn + 1,
+		ByteColumn: lexer.position - lexer.lineStart + 1,
 	}
 }
 
@@ -62,71 +126,111 @@ func (lexer *Lexer) eatChar() (char string) {
 	lexer.eatBlankSpace()
 
 	char, _ = lexer.peekChar()
-	lexer.position += 1
-	return
-}
-
-func (lexer *Lexer) eatNumber() (char string) {
-	lexer.eatBlankSpace()
-
-	ch, err := lexer.peekChar()
-	for err == nil && isDigit(ch) {
-		char += lexer.eatChar()
-		if lexer.position >= len(lexer.input) || lexer.input[lexer.position] == ' ' {
-			break
-		}
-		ch, err = lexer.peekChar()
-	}
+	lexer.advance()
 	return
 }
 
-func (lexer *Lexer) eatWord() (char string) {
-	lexer.eatBlankSpace()
-
-	ch, err := lexer.peekChar()
-	for err == nil && isLetter(ch) {
-		char += lexer.eatChar()
-		if lexer.position >= len(lexer.input) || lexer.input[lexer.position] == ' ' {
-			break
-		}
-		ch, err = lexer.peekChar()
-	}
-	return
+func (lexer *Lexer) eatWord() string {
+	return lexer.eatWhile(isLetterOrDigit)
 }
 
+// peekChar returns the rune under the reading head as a string without
+// moving past it. Bytes that are not valid UTF-8 come back one at a time.
 func (lexer *Lexer) peekChar() (string, error) {
 	lexer.eatBlankSpace()
 
-	if lexer.position < len(lexer.input)-1 {
-		return lexer.input[lexer.position : lexer.position+1], nil
-	} else if lexer.position == len(lexer.input)-1 {
-		return lexer.input[lexer.position:], nil
-	} else {
-		return "", errors.New("EOF")
-	}
+	return lexer.peekAdjacentChar()
 }
 
 func New(input string) Lexer {
 	return Lexer{input: input}
 }
 
+func isBlank(ch byte) bool {
+	return ch == ' ' || ch == '\n' || ch == '\t' || ch == '\r'
+}
+
 func isDigit(ch string) bool {
 	return '0' <= ch[0] && ch[0] <= '9'
 }
 
-func isAtom(s string) bool {
-	_, ok := dictAtom[s]
-	return ok
+// isLetter reports whether ch may start an identifier. Like Go, identifiers
+// start with a Unicode letter (category L) or `_` and continue with letters,
+// `_` and Unicode decimal digits (category Nd), so `größe` and `变量1` are
+// identifiers but `1x` is not. Number literals only use ASCII digits.
+func isLetter(ch string) bool {
+	r, _ := utf8.DecodeRuneInString(ch)
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isLetterOrDigit(ch string) bool {
+	r, _ := utf8.DecodeRuneInString(ch)
+	return isLetter(ch) || unicode.IsDigit(r)
 }
 
-func isLetter(s string) bool {
-	return 'a' <= s[0] && s[0] <= 'z' || 'A' <= s[0] && s[0] <= 'Z' || s[0] == '_'
+func isInvalidUTF8(ch string) bool {
+	r, width := utf8.DecodeRuneInString(ch)
+	return r == utf8.RuneError && width <= 1
 }
 
+// lexed is a token read ahead by Peek together with its error.
+type lexed struct {
+	token token.Token
+	err   error
+}
+
+// NextToken reads the next token and records the span it covers.
 func (lexer *Lexer) NextToken() (token.Token, error) {
+	if len(lexer.peeked) > 0 {
+		next := lexer.peeked[0]
+		lexer.peeked = lexer.peeked[1:]
+		return next.token, next.err
+	}
+	return lexer.lex()
+}
+
+// Peek returns the token the n-th next call to NextToken will return,
+// counting from 1, without consuming anything.
+func (lexer *Lexer) Peek(n int) (token.Token, error) {
+	if n < 1 {
+		return token.New(token.ILLEGAL, ""), fmt.Errorf("can not peek %d tokens ahead", n)
+	}
+	for len(lexer.peeked) < n {
+		t, err := lexer.lex()
+		lexer.peeked = append(lexer.peeked, lexed{token: t, err: err})
+	}
+	ahead := lexer.peeked[n-1]
+	return ahead.token, ahead.err
+}
+
+func (lexer *Lexer) lex() (token.Token, error) {
+	lexer.discard()
+	lexer.eatBlankSpace()
+	for !lexer.EmitComments && lexer.startsComment() {
+		start := lexer.currentPosition()
+		if text, err := lexer.eatComment(); err != nil {
+			return token.Token{
+				Class:   token.ILLEGAL,
+				Literal: text,
+				Span:    token.Span{Start: start, End: lexer.currentPosition()},
+			}, fmt.Errorf("%v: %w", start, err)
+		}
+		lexer.eatBlankSpace()
+	}
+	start := lexer.currentPosition()
+	t, err := lexer.nextToken(start)
+	t.Span = token.Span{Start: start, End: lexer.currentPosition()}
+	return t, err
+}
+
+func (lexer *Lexer) nextToken(start token.Position) (token.Token, error) {
 	ch, err := lexer.peekChar()
 
 	if err != nil {
+		if lexer.readErr != nil {
+			err, lexer.readErr = lexer.readErr, nil
+			return token.New(token.ILLEGAL, ""), fmt.Errorf("%v: %w", start, err)
+		}
 		return token.Token{
 			Class:   token.EOF,
 			Literal: "EOF",
@@ -134,18 +238,24 @@ func (lexer *Lexer) NextToken() (token.Token, error) {
 	}
 
 	switch {
-	case isAtom(ch) || isPrefixOfMultipleAtoms(ch):
+	case lexer.startsComment():
 		{
-			var word string
-			if isPrefixOfMultipleAtoms(ch) {
-				word = lexer.eatWhile(isPrefixOfMultipleAtoms)
-				if t, err := lexer.tryKeyword(word); err == nil {
-					return t, err
-				}
-			} else {
-				word = lexer.eatChar()
+			text, err := lexer.eatComment()
+			if err != nil {
+				return token.New(token.ILLEGAL, text), fmt.Errorf("%v: %w", start, err)
 			}
-			return lexer.tryAtom(word)
+			return token.New(token.COMMENT, text), nil
+		}
+	case lexer.startsOperator(ch):
+		{
+			// `!!` is two bangs, while `!=` is one token
+			if t, ok := lexer.eatOperator(); ok {
+				return t, nil
+			}
+			// a lone `&` starts `&&` but is no operator itself
+			word := lexer.eatChar()
+			_, err := lexer.tryAtom(word)
+			return token.New(token.ILLEGAL, word), fmt.Errorf("%v: %w", start, err)
 		}
 	case isLetter(ch):
 		{
@@ -165,28 +275,33 @@ func (lexer *Lexer) NextToken() (token.Token, error) {
 		}
 	case isDigit(ch):
 		{
-			number := lexer.eatNumber()
-			return lexer.tryInteger(number)
+			number, class := lexer.eatNumber()
+			return token.New(class, number), nil
+		}
+	case ch == `"`:
+		{
+			str, err := lexer.eatString()
+			if err != nil {
+				return token.New(token.ILLEGAL, str), fmt.Errorf("%v: %w", start, err)
+			}
+			return token.New(token.STRING, str), nil
 		}
 	}
-	return token.New(token.ILLEGAL, ""),
-		fmt.Errorf("illegal token %v at %v", ch, lexer.position)
-}
-
-func isPrefixOfMultipleAtoms(ch string) bool {
-	return strings.ContainsAny(ch, "&!=|")
-}
-
-func (lexer *Lexer) tryInteger(number string) (token.Token, error) {
-	return token.New(token.INT, number), nil
+	lexer.advance()
+	if isInvalidUTF8(ch) {
+		return token.New(token.ILLEGAL, ch),
+			fmt.Errorf("%v: invalid UTF-8 byte %#x", start, ch[0])
+	}
+	return token.New(token.ILLEGAL, ch),
+		fmt.Errorf("%v: illegal token %v", start, ch)
 }
 
 func (lexer *Lexer) tryAtom(word string) (token.Token, error) {
-	t, ok := dictAtom[word]
+	t, ok := lexer.operatorTable().operators[word]
 	if ok {
 		return t, nil
 	} else {
-		return token.Token{}, errors.New("unknown atom")
+		return token.Token{}, fmt.Errorf("unknown atom %s", word)
 	}
 }
 
@@ -201,11 +316,25 @@ func (lexer *Lexer) tryIdentifier(word string) (token.Token, error) {
 	return token.New(token.IDENT, word), nil
 }
 
+// eatWhile skips leading blanks, then reads runes while predicate holds. It
+// stops at the first blank.
 func (lexer *Lexer) eatWhile(predicate func(ch string) bool) string {
-	ans := ""
-	for ch, err := lexer.peekChar(); predicate(ch) && err == nil; {
-		ans += lexer.eatChar()
-		ch, err = lexer.peekChar()
+	lexer.eatBlankSpace()
+
+	start := lexer.position
+	for ch, err := lexer.peekAdjacentChar(); err == nil && predicate(ch); ch, err = lexer.peekAdjacentChar() {
+		lexer.advance()
+	}
+	return lexer.input[start:lexer.position]
+}
+
+// peekAdjacentChar is peekChar without skipping blanks first: a blank under
+// the reading head is returned as it is.
+func (lexer *Lexer) peekAdjacentChar() (string, error) {
+	rest := lexer.rest(utf8.UTFMax)
+	if rest == "" {
+		return "", errors.New("EOF")
 	}
-	return ans
+	_, width := utf8.DecodeRuneInString(rest)
+	return rest[:width], nil
 }
@@ -145,7 +145,7 @@ add(five, ten);
 }
 
 func TestLexer_NextToken_ShouldReadMoreOperators(t *testing.T) {
-	input := "!-/*5;\n5 <10 >5;"
+	input := "!-/ *5;\n5 <10 >5;"
 
 	tests := []struct {
 		expectedClass   token.Class
@@ -217,6 +217,36 @@ func TestLexer_NextToken_ShouldReadMoreKeywords(t *testing.T) {
 	}
 }
 
+func TestLexer_NextToken_ShouldReadLoopKeywords(t *testing.T) {
+	input := "while for in break continue inside forever"
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.WHILE, "while"},
+		{token.FOR, "for"},
+		{token.IN, "in"},
+		{token.BREAK, "break"},
+		{token.CONTINUE, "continue"},
+		{token.IDENT, "inside"},
+		{token.IDENT, "forever"},
+		{token.EOF, "EOF"},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, _ := lexer.NextToken()
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
+
 func TestLexer_NextToken_ShouldReadMultipleCharOperators(t *testing.T) {
 	input := "if(89!=64&&true==false||true){\n\n}"
 
@@ -253,3 +283,63 @@ func TestLexer_NextToken_ShouldReadMultipleCharOperators(t *testing.T) {
 		}
 	}
 }
+
+func TestLexer_NextToken_ShouldTrackPositions(t *testing.T) {
+	input := "let x = 5;\n  x +\n\tyy"
+
+	tests := []struct {
+		expectedLiteral string
+		expectedStart   token.Position
+		expectedEnd     token.Position
+	}{
+		{"let", token.Position{Offset: 0, Line: 1, Column: 1, ByteColumn: 1}, token.Position{Offset: 3, Line: 1, Column: 4, ByteColumn: 4}},
+		{"x", token.Position{Offset: 4, Line: 1, Column: 5, ByteColumn: 5}, token.Position{Offset: 5, Line: 1, Column: 6, ByteColumn: 6}},
+		{"=", token.Position{Offset: 6, Line: 1, Column: 7, ByteColumn: 7}, token.Position{Offset: 7, Line: 1, Column: 8, ByteColumn: 8}},
+		{"5", token.Position{Offset: 8, Line: 1, Column: 9, ByteColumn: 9}, token.Position{Offset: 9, Line: 1, Column: 10, ByteColumn: 10}},
+		{";", token.Position{Offset: 9, Line: 1, Column: 10, ByteColumn: 10}, token.Position{Offset: 10, Line: 1, Column: 11, ByteColumn: 11}},
+		{"x", token.Position{Offset: 13, Line: 2, Column: 3, ByteColumn: 3}, token.Position{Offset: 14, Line: 2, Column: 4, ByteColumn: 4}},
+		{"+", token.Position{Offset: 15, Line: 2, Column: 5, ByteColumn: 5}, token.Position{Offset: 16, Line: 2, Column: 6, ByteColumn: 6}},
+		{"yy", token.Position{Offset: 18, Line: 3, Column: 2, ByteColumn: 2}, token.Position{Offset: 20, Line: 3, Column: 4, ByteColumn: 4}},
+		{"EOF", token.Position{Offset: 20, Line: 3, Column: 4, ByteColumn: 4}, token.Position{Offset: 20, Line: 3, Column: 4, ByteColumn: 4}},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, _ := lexer.NextToken()
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+		if tok.Span.Start != tt.expectedStart || tok.Span.End != tt.expectedEnd {
+			t.Fatalf("tests[%d] - span of %q wrong. expected=%v-%v, got=%v",
+				i, tok.Literal, tt.expectedStart, tt.expectedEnd, tok.Span)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldSplitWordsOnAnyBlank(t *testing.T) {
+	input := "a\nb\t12\n34"
+	expected := []string{"a", "b", "12", "34", "EOF"}
+
+	lexer := New(input)
+	for i, exp := range expected {
+		tok, _ := lexer.NextToken()
+		if tok.Literal != exp {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, exp, tok.Literal)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldReadComparisonsAndModulo(t *testing.T) {
+	input := "a<=b>=c%d< =e"
+	expected := []token.Class{
+		token.IDENT, token.LTE, token.IDENT, token.GTE, token.IDENT,
+		token.PERCENT, token.IDENT, token.LT, token.ASSIGN, token.IDENT, token.EOF,
+	}
+	lexer := New(input)
+	for i, class := range expected {
+		if tok, _ := lexer.NextToken(); tok.Class != class {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, class, tok.Class)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package lexer
+
+import (
+	"interpreter/token"
+	"strings"
+)
+
+// eatNumber reads a numeric literal without checking it; the parser does
+// that. It takes
+//
+//   - integers with a base prefix: 0xFF, 0o17, 0b1010
+//   - decimal integers: 42, 1_000_000
+//   - decimal floats with a fraction, an exponent or both: 3.14, 1e-9, 2.5E+3
+//
+// Letters and `_` right after the digits are read as part of the literal, so
+// `12abc` is reported as one malformed number rather than 12 followed by abc.
+func (lexer *Lexer) eatNumber() (string, token.Class) {
+	lexer.eatBlankSpace()
+
+	start := lexer.position
+	if hasBasePrefix(lexer.rest(2)) {
+		lexer.advance()
+		lexer.advance()
+		lexer.eatWhile(isNumberChar)
+		return lexer.input[start:lexer.position], token.INT
+	}
+
+	class := token.Class(token.INT)
+	lexer.eatWhile(isNumberChar)
+	// a dot only belongs to the number when a digit follows it
+	if rest := lexer.rest(2); len(rest) >= 2 && rest[0] == '.' && isDigit(rest[1:2]) {
+		class = token.FLOAT
+		lexer.advance()
+		lexer.eatWhile(isNumberChar)
+	}
+	if last := lexer.input[lexer.position-1]; last == 'e' || last == 'E' {
+		if rest := lexer.rest(1); strings.HasPrefix(rest, "+") || strings.HasPrefix(rest, "-") {
+			lexer.advance()
+			lexer.eatWhile(isNumberChar)
+		}
+	}
+	if strings.ContainsAny(lexer.input[start:lexer.position], "eE") {
+		class = token.FLOAT
+	}
+	return lexer.input[start:lexer.position], class
+}
+
+func hasBasePrefix(s string) bool {
+	if len(s) < 2 || s[0] != '0' {
+		return false
+	}
+	return strings.ContainsRune("xXoObB", rune(s[1]))
+}
+
+func isNumberChar(ch string) bool {
+	c := ch[0]
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
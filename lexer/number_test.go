@@ -0,0 +1,49 @@
+package lexer
+
+import (
+	"interpreter/token"
+	"testing"
+)
+
+func TestLexer_NextToken_ShouldReadNumbers(t *testing.T) {
+	input := "0xFF 0o17 0B1010 1_000_000 3.14 1e-9 2.5E+3 7e3 0x1e-5 1.x 12abc 5.;"
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.INT, "0xFF"},
+		{token.INT, "0o17"},
+		{token.INT, "0B1010"},
+		{token.INT, "1_000_000"},
+		{token.FLOAT, "3.14"},
+		{token.FLOAT, "1e-9"},
+		{token.FLOAT, "2.5E+3"},
+		{token.FLOAT, "7e3"},
+		// hexadecimal digits include e, so there is no exponent to sign
+		{token.INT, "0x1e"},
+		{token.MINUS, "-"},
+		{token.INT, "5"},
+		// a dot only continues a number when a digit follows
+		{token.INT, "1"},
+		{token.ILLEGAL, "."},
+		{token.IDENT, "x"},
+		{token.INT, "12abc"},
+		{token.INT, "5"},
+		{token.ILLEGAL, "."},
+		{token.SEMICOLON, ";"},
+		{token.EOF, "EOF"},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, _ := lexer.NextToken()
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
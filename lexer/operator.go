@@ -0,0 +1,110 @@
+package lexer
+
+import (
+	"errors"
+	"fmt"
+	"interpreter/token"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// operatorTable holds the operators and punctuation a lexer knows. Longer
+// operators win over their prefixes, so `==` is one token and `=` another.
+type operatorTable struct {
+	operators map[string]token.Token
+	// starts holds the first rune of every operator
+	starts map[string]bool
+	// longest is the length in bytes of the longest operator
+	longest int
+}
+
+var defaultOperators = newOperatorTable(dictAtom)
+
+func newOperatorTable(operators map[string]token.Token) *operatorTable {
+	table := &operatorTable{
+		operators: make(map[string]token.Token),
+		starts:    make(map[string]bool),
+	}
+	for literal, t := range operators {
+		table.add(literal, t)
+	}
+	return table
+}
+
+func (table *operatorTable) add(literal string, t token.Token) {
+	table.operators[literal] = t
+	_, width := utf8.DecodeRuneInString(literal)
+	table.starts[literal[:width]] = true
+	if len(literal) > table.longest {
+		table.longest = len(literal)
+	}
+}
+
+// AddOperator teaches the lexer an operator such as `**` or `|>`, read as a
+// token of the given class. Operators must pass CheckOperator and be added
+// before the first token is read. Adding an operator that exists already
+// changes its class.
+func (lexer *Lexer) AddOperator(operator string, class token.Class) error {
+	if err := CheckOperator(operator); err != nil {
+		return err
+	}
+
+	// copy on first write, the default table is shared by all lexers
+	if lexer.operators == nil || lexer.operators == defaultOperators {
+		lexer.operators = newOperatorTable(lexer.operatorTable().operators)
+	}
+	lexer.operators.add(operator, token.New(class, operator))
+	return nil
+}
+
+// CheckOperator reports whether operator can be lexed as one: it must be made
+// of Unicode punctuation and symbols other than `"` and the `_` identifiers
+// are made of, and must not start a comment.
+func CheckOperator(operator string) error {
+	if operator == "" {
+		return errors.New("an operator can not be empty")
+	}
+	for _, r := range operator {
+		if r == '"' || isLetterOrDigit(string(r)) || !unicode.IsPunct(r) && !unicode.IsSymbol(r) {
+			return fmt.Errorf("operator %q may only hold punctuation and symbols, not %q", operator, r)
+		}
+	}
+	if strings.HasPrefix(operator, "//") || strings.HasPrefix(operator, "/*") {
+		return fmt.Errorf("operator %q would start a comment", operator)
+	}
+	return nil
+}
+
+func (lexer *Lexer) operatorTable() *operatorTable {
+	if lexer.operators == nil {
+		return defaultOperators
+	}
+	return lexer.operators
+}
+
+func (lexer *Lexer) startsOperator(ch string) bool {
+	return lexer.operatorTable().starts[ch]
+}
+
+// eatOperator reads the longest operator at the reading head, if any.
+func (lexer *Lexer) eatOperator() (token.Token, bool) {
+	table := lexer.operatorTable()
+	rest := lexer.rest(table.longest)
+	n := table.longest
+	if n > len(rest) {
+		n = len(rest)
+	}
+	for ; n > 0; n-- {
+		t, ok := table.operators[rest[:n]]
+		if !ok {
+			continue
+		}
+		end := lexer.position + n
+		for lexer.position < end {
+			lexer.advance()
+		}
+		return t, true
+	}
+	return token.Token{}, false
+}
@@ -0,0 +1,141 @@
+package lexer
+
+import (
+	"interpreter/token"
+	"testing"
+)
+
+func TestLexer_AddOperator(t *testing.T) {
+	input := "a ** b *** c |> f .. 1..5 & !== ~"
+
+	lexer := New(input)
+	for _, op := range []string{"**", "|>", "..", "!==", "~"} {
+		if err := lexer.AddOperator(op, token.Class(op)); err != nil {
+			t.Fatalf("AddOperator(%q) failed: %v", op, err)
+		}
+	}
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.IDENT, "a"},
+		{"**", "**"},
+		{token.IDENT, "b"},
+		// the longest operator wins
+		{"**", "**"},
+		{token.ASTERISK, "*"},
+		{token.IDENT, "c"},
+		{"|>", "|>"},
+		{token.IDENT, "f"},
+		{"..", ".."},
+		{token.INT, "1"},
+		{"..", ".."},
+		{token.INT, "5"},
+		{token.ILLEGAL, "&"},
+		{"!==", "!=="},
+		{"~", "~"},
+		{token.EOF, "EOF"},
+	}
+	for i, tt := range tests {
+		tok, _ := lexer.NextToken()
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+
+	// other lexers keep the default operators
+	other := New("~")
+	if tok, _ := other.NextToken(); tok.Class != token.ILLEGAL {
+		t.Fatalf("operator leaked into another lexer. got=%q", tok.Class)
+	}
+}
+
+func TestCheckOperator(t *testing.T) {
+	tests := []struct {
+		operator string
+		valid    bool
+	}{
+		{"**", true},
+		{"|>", true},
+		{"→", true},
+		{"", false},
+		{"a+", false},
+		{"+1", false},
+		{"+ +", false},
+		{`+"`, false},
+		{"//=", false},
+		{"/*", false},
+		{"_", false},
+		{"+_", false},
+		{"_|", false},
+	}
+	for _, tt := range tests {
+		if err := CheckOperator(tt.operator); (err == nil) != tt.valid {
+			t.Errorf("CheckOperator(%q) wrong. expected valid=%t, got err=%v",
+				tt.operator, tt.valid, err)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldReadPower(t *testing.T) {
+	input := "2**3 * *4***5"
+	expected := []token.Class{
+		token.INT, token.POWER, token.INT, token.ASTERISK, token.ASTERISK,
+		token.INT, token.POWER, token.ASTERISK, token.INT, token.EOF,
+	}
+	lexer := New(input)
+	for i, class := range expected {
+		if tok, _ := lexer.NextToken(); tok.Class != class {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, class, tok.Class)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldReadCompoundAssignments(t *testing.T) {
+	input := "+= -= *= /= %= **= = =="
+	expected := []token.Class{
+		token.PLUS_ASSIGN, token.MINUS_ASSIGN, token.ASTERISK_ASSIGN, token.SLASH_ASSIGN,
+		token.PERCENT_ASSIGN, token.POWER_ASSIGN, token.ASSIGN, token.EQUAL, token.EOF,
+	}
+	lexer := New(input)
+	for i, class := range expected {
+		if tok, _ := lexer.NextToken(); tok.Class != class {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, class, tok.Class)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldReadArrow(t *testing.T) {
+	input := "x=>x (a,b) => a >= b ==> = >"
+	expected := []token.Class{
+		token.IDENT, token.ARROW, token.IDENT,
+		token.LPAREN, token.IDENT, token.COMMA, token.IDENT, token.RPAREN, token.ARROW,
+		token.IDENT, token.GTE, token.IDENT, token.EQUAL, token.GT, token.ASSIGN, token.GT, token.EOF,
+	}
+	lexer := New(input)
+	for i, class := range expected {
+		if tok, _ := lexer.NextToken(); tok.Class != class {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, class, tok.Class)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldReadEllipsis(t *testing.T) {
+	input := "f(...xs) ...[1]"
+	expected := []token.Class{
+		token.IDENT, token.LPAREN, token.ELLIPSIS, token.IDENT, token.RPAREN,
+		token.ELLIPSIS, token.LBRACKET, token.INT, token.RBRACKET, token.EOF,
+	}
+	lexer := New(input)
+	for i, class := range expected {
+		if tok, _ := lexer.NextToken(); tok.Class != class {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, class, tok.Class)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package lexer
+
+import (
+	"io"
+)
+
+// chunkSize is how many bytes a streaming lexer asks its reader for at once.
+const chunkSize = 4096
+
+// NewReader creates a lexer that reads its input from r as it goes. Only the
+// token being read and whatever Peek looked ahead at are kept in memory, so
+// large scripts never need to be loaded whole.
+func NewReader(r io.Reader) Lexer {
+	return Lexer{reader: r, chunk: make([]byte, chunkSize)}
+}
+
+// fill buffers at least n bytes past position unless the reader runs dry
+// first, and reports whether they are there.
+func (lexer *Lexer) fill(n int) bool {
+	for len(lexer.input)-lexer.position < n && lexer.reader != nil {
+		read, err := lexer.reader.Read(lexer.chunk)
+		lexer.input += string(lexer.chunk[:read])
+		if err != nil {
+			if err != io.EOF {
+				lexer.readErr = err
+			}
+			lexer.reader = nil
+		}
+	}
+	return len(lexer.input)-lexer.position >= n
+}
+
+// rest returns the buffered input from position on, after trying to buffer
+// at least n bytes of it.
+func (lexer *Lexer) rest(n int) string {
+	lexer.fill(n)
+	return lexer.input[lexer.position:]
+}
+
+// more reports whether any input is left.
+func (lexer *Lexer) more() bool {
+	return lexer.fill(1)
+}
+
+// discard drops the input before position. It is only called between tokens,
+// so no slice of the buffer in use is invalidated.
+func (lexer *Lexer) discard() {
+	lexer.input = lexer.input[lexer.position:]
+	lexer.base += lexer.position
+	lexer.lineStart -= lexer.position
+	lexer.position = 0
+}
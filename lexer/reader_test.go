@@ -0,0 +1,126 @@
+package lexer
+
+import (
+	"errors"
+	"interpreter/token"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func lexAll(t *testing.T, lexer *Lexer) []token.Token {
+	var tokens []token.Token
+	for it := lexer.Tokens(); it.Next(); {
+		if it.Err() != nil {
+			t.Fatalf("unexpected error %v", it.Err())
+		}
+		tokens = append(tokens, it.Token())
+	}
+	return tokens
+}
+
+func TestNewReader_ShouldLexLikeNew(t *testing.T) {
+	input := `let größe = "日本\u{1F600}"; // comment
+/* block /* nested */ */ if (größe != "") { größe[0] } else { -10 / 2 }
+`
+	expectedLexer := New(input)
+	expected := lexAll(t, &expectedLexer)
+
+	// one byte at a time splits runes, escapes and comment delimiters
+	// across reads
+	actualLexer := NewReader(iotest.OneByteReader(strings.NewReader(input)))
+	actual := lexAll(t, &actualLexer)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("wrong number of tokens. expected=%d, got=%d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("tests[%d] - token wrong. expected=%+v, got=%+v",
+				i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestNewReader_ShouldOnlyBufferTheCurrentToken(t *testing.T) {
+	line := "let x = 1; // padding padding padding\n"
+	lines := 10 * chunkSize / len(line)
+	lexer := NewReader(strings.NewReader(strings.Repeat(line, lines)))
+
+	count := 0
+	var last token.Token
+	for it := lexer.Tokens(); it.Next(); count++ {
+		last = it.Token()
+		if len(lexer.input) > 2*chunkSize {
+			t.Fatalf("buffered %d bytes after %d tokens", len(lexer.input), count)
+		}
+	}
+	if count != 5*lines {
+		t.Fatalf("wrong number of tokens. expected=%d, got=%d", 5*lines, count)
+	}
+	expectedEnd := token.Position{
+		Offset:     lines*len(line) - len(line) + len("let x = 1;"),
+		Line:       lines,
+		Column:     11,
+		ByteColumn: 11,
+	}
+	if last.Span.End != expectedEnd {
+		t.Fatalf("last token ends wrong. expected=%+v, got=%+v", expectedEnd, last.Span.End)
+	}
+}
+
+func TestNewReader_ShouldReportReadErrors(t *testing.T) {
+	failure := errors.New("disk on fire")
+	// the second read times out, so " x" never arrives
+	lexer := NewReader(iotest.TimeoutReader(strings.NewReader("let x")))
+	lexer.chunk = make([]byte, 3)
+
+	expected := []struct {
+		class token.Class
+		err   error
+	}{
+		{token.LET, nil},
+		{token.ILLEGAL, iotest.ErrTimeout},
+		{token.EOF, nil},
+	}
+	for i, tt := range expected {
+		tok, err := lexer.NextToken()
+		if tok.Class != tt.class {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q", i, tt.class, tok.Class)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("tests[%d] - error wrong. expected=%v, got=%v", i, tt.err, err)
+		}
+	}
+
+	lexer = NewReader(iotest.ErrReader(failure))
+	if _, err := lexer.NextToken(); !errors.Is(err, failure) {
+		t.Fatalf("error wrong. expected=%v, got=%v", failure, err)
+	}
+}
+
+func TestLexer_Peek(t *testing.T) {
+	lexer := New("a + b")
+
+	for _, n := range []int{3, 1, 2} {
+		expected := []string{"a", "+", "b"}[n-1]
+		if tok, _ := lexer.Peek(n); tok.Literal != expected {
+			t.Fatalf("Peek(%d) wrong. expected=%q, got=%q", n, expected, tok.Literal)
+		}
+	}
+	if tok, _ := lexer.Peek(5); tok.Class != token.EOF {
+		t.Fatalf("Peek(5) wrong. expected=%q, got=%q", token.EOF, tok.Class)
+	}
+	if _, err := lexer.Peek(0); err == nil {
+		t.Fatalf("Peek(0) did not fail")
+	}
+
+	for i, expected := range []string{"a", "+", "b", "EOF"} {
+		if tok, _ := lexer.NextToken(); tok.Literal != expected {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q", i, expected, tok.Literal)
+		}
+		if tok, _ := lexer.Peek(1); i < 2 && tok.Literal != []string{"+", "b"}[i] {
+			t.Fatalf("tests[%d] - Peek(1) after NextToken wrong. got=%q", i, tok.Literal)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package lexer
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// eatString reads a double-quoted string literal and returns its value with
+// escape sequences resolved. Blanks inside the quotes are kept as they are.
+// A bad escape or byte does not end the string: it is read up to its closing
+// quote all the same, so that lexing goes on after it, and the first error
+// is returned.
+func (lexer *Lexer) eatString() (string, error) {
+	var out strings.Builder
+	var firstErr error
+	lexer.advance() // opening quote
+	for lexer.more() {
+		ch := lexer.input[lexer.position]
+		switch ch {
+		case '"':
+			{
+				lexer.advance()
+				return out.String(), firstErr
+			}
+		case '\\':
+			{
+				escaped, err := lexer.eatEscape()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+					continue
+				}
+				out.WriteRune(escaped)
+			}
+		default:
+			{
+				r, width := utf8.DecodeRuneInString(lexer.rest(utf8.UTFMax))
+				if r == utf8.RuneError && width <= 1 {
+					lexer.advance()
+					if firstErr == nil {
+						firstErr = fmt.Errorf("invalid UTF-8 byte %#x in string", ch)
+					}
+					continue
+				}
+				out.WriteRune(r)
+				lexer.advance()
+			}
+		}
+	}
+	if firstErr != nil {
+		return out.String(), firstErr
+	}
+	return out.String(), errors.New("unterminated string")
+}
+
+var dictEscape = map[rune]rune{
+	'n':  '\n',
+	't':  '\t',
+	'"':  '"',
+	'\\': '\\',
+}
+
+// eatEscape reads one escape sequence: \n, \t, \", \\ or \u{...} with one to
+// six hexadecimal digits naming a Unicode code point.
+func (lexer *Lexer) eatEscape() (rune, error) {
+	lexer.advance() // backslash
+	if !lexer.more() {
+		return 0, errors.New("unterminated string")
+	}
+
+	ch, _ := utf8.DecodeRuneInString(lexer.rest(utf8.UTFMax))
+	lexer.advance()
+	if escaped, ok := dictEscape[ch]; ok {
+		return escaped, nil
+	}
+	if ch != 'u' {
+		return 0, fmt.Errorf("unknown escape sequence \\%c", ch)
+	}
+
+	if !lexer.more() || lexer.input[lexer.position] != '{' {
+		return 0, errors.New("expected { after \\u")
+	}
+	lexer.advance()
+	// the scan stops at the closing quote too, and one character past the
+	// longest escape, so that a missing brace never eats the code after it
+	start := lexer.position
+	for lexer.more() && lexer.position-start <= 6 &&
+		lexer.input[lexer.position] != '}' && lexer.input[lexer.position] != '"' {
+		lexer.advance()
+	}
+	digits := lexer.input[start:lexer.position]
+	if !lexer.more() || lexer.input[lexer.position] != '}' {
+		if len(digits) > 6 {
+			return 0, fmt.Errorf("\\u{%s...} must have one to six hex digits", digits)
+		}
+		return 0, errors.New("unterminated \\u{...} escape")
+	}
+	lexer.advance() // closing brace
+
+	if len(digits) == 0 || len(digits) > 6 {
+		return 0, fmt.Errorf("\\u{%s} must have one to six hex digits", digits)
+	}
+	code, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || !utf8.ValidRune(rune(code)) {
+		return 0, fmt.Errorf("\\u{%s} is not a valid code point", digits)
+	}
+	return rune(code), nil
+}
@@ -0,0 +1,132 @@
+package lexer
+
+import (
+	"interpreter/token"
+	"strings"
+	"testing"
+)
+
+func TestLexer_NextToken_ShouldReadStrings(t *testing.T) {
+	input := `let s = "hello  world"; "a\nb\t\"c\"\\" + "\u{48}\u{1F600}";""`
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.LET, "let"},
+		{token.IDENT, "s"},
+		{token.ASSIGN, "="},
+		{token.STRING, "hello  world"},
+		{token.SEMICOLON, ";"},
+		{token.STRING, "a\nb\t\"c\"\\"},
+		{token.PLUS, "+"},
+		{token.STRING, "H\U0001F600"},
+		{token.SEMICOLON, ";"},
+		{token.STRING, ""},
+		{token.EOF, "EOF"},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, err := lexer.NextToken()
+		if err != nil {
+			t.Fatalf("tests[%d] - unexpected error %v", i, err)
+		}
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
+
+func TestLexer_NextToken_StringSpanCoversQuotes(t *testing.T) {
+	lexer := New(`  "a\tb"  `)
+	tok, _ := lexer.NextToken()
+	if tok.Span.Start.Offset != 2 || tok.Span.End.Offset != 8 {
+		t.Fatalf("span wrong. got=%v", tok.Span)
+	}
+}
+
+func TestLexer_NextToken_ShouldReportBadStrings(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"abc`, "unterminated string"},
+		{`"abc\`, "unterminated string"},
+		{`"\q"`, `unknown escape sequence \q`},
+		{`"\u0041"`, `expected { after \u`},
+		{`"\u{}"`, "one to six hex digits"},
+		{`"\u{1234567}"`, "one to six hex digits"},
+		{`"\u{zz}"`, "not a valid code point"},
+		{`"\u{D800}"`, "not a valid code point"},
+		{`"\u{41"`, `unterminated \u{...} escape`},
+		{`"\u{41`, `unterminated \u{...} escape`},
+		{`"\u{123456789}"`, "one to six hex digits"},
+	}
+	for _, tt := range tests {
+		lexer := New(tt.input)
+		tok, err := lexer.NextToken()
+		if err == nil {
+			t.Fatalf("%s: no error returned, got %+v", tt.input, tok)
+		}
+		if tok.Class != token.ILLEGAL {
+			t.Errorf("%s: tokentype wrong. expected=%q, got=%q",
+				tt.input, token.ILLEGAL, tok.Class)
+		}
+		if !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%s: wrong error. expected=%q, got=%q",
+				tt.input, tt.expected, err)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldGoOnAfterBadStrings(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"bad\q escape"`, `unknown escape sequence \q`},
+		{`"\q\r \" still inside"`, `unknown escape sequence \q`},
+		{`"\u0041"`, `expected { after \u`},
+		{`"\u{}"`, "one to six hex digits"},
+		{`"\u{zz} x"`, "not a valid code point"},
+		{"\"a\xffb\"", "invalid UTF-8 byte 0xff in string"},
+		{`"\u{41"`, `unterminated \u{...} escape`},
+		{`"\u{41 x"`, `unterminated \u{...} escape`},
+		{`"\u{123456789}"`, "one to six hex digits"},
+	}
+	next := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.SEMICOLON, ";"},
+		{token.LET, "let"},
+		{token.IDENT, "b"},
+		{token.ASSIGN, "="},
+		{token.STRING, "ok"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, "EOF"},
+	}
+	for _, tt := range tests {
+		lexer := New(tt.input + `; let b = "ok";`)
+		tok, err := lexer.NextToken()
+		if tok.Class != token.ILLEGAL || err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Fatalf("%s: wrong first token. expected ILLEGAL with %q, got %q, %v",
+				tt.input, tt.expected, tok.Class, err)
+		}
+		for i, n := range next {
+			tok, err := lexer.NextToken()
+			if err != nil {
+				t.Fatalf("%s: next[%d] - unexpected error %v", tt.input, i, err)
+			}
+			if tok.Class != n.expectedClass || tok.Literal != n.expectedLiteral {
+				t.Fatalf("%s: next[%d] wrong. expected=%q %q, got=%q %q",
+					tt.input, i, n.expectedClass, n.expectedLiteral, tok.Class, tok.Literal)
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package lexer
+
+import (
+	"interpreter/token"
+	"strings"
+	"testing"
+)
+
+func TestLexer_NextToken_ShouldReadUnicodeIdentifiers(t *testing.T) {
+	input := `let größe = "héllo, 世界"; 变量 + _x1 + x١; !!ok`
+
+	tests := []struct {
+		expectedClass   token.Class
+		expectedLiteral string
+	}{
+		{token.LET, "let"},
+		{token.IDENT, "größe"},
+		{token.ASSIGN, "="},
+		{token.STRING, "héllo, 世界"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "变量"},
+		{token.PLUS, "+"},
+		{token.IDENT, "_x1"},
+		{token.PLUS, "+"},
+		{token.IDENT, "x١"},
+		{token.SEMICOLON, ";"},
+		{token.BANG, "!"},
+		{token.BANG, "!"},
+		{token.IDENT, "ok"},
+		{token.EOF, "EOF"},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, err := lexer.NextToken()
+		if err != nil {
+			t.Fatalf("tests[%d] - unexpected error %v", i, err)
+		}
+		if tok.Class != tt.expectedClass {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, tt.expectedClass, tok.Class)
+		}
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldCountRuneAndByteColumns(t *testing.T) {
+	input := "größe = \"日本\"\n\t变量"
+
+	tests := []struct {
+		expectedLiteral string
+		expectedStart   token.Position
+		expectedEnd     token.Position
+	}{
+		{"größe", token.Position{Offset: 0, Line: 1, Column: 1, ByteColumn: 1}, token.Position{Offset: 7, Line: 1, Column: 6, ByteColumn: 8}},
+		{"=", token.Position{Offset: 8, Line: 1, Column: 7, ByteColumn: 9}, token.Position{Offset: 9, Line: 1, Column: 8, ByteColumn: 10}},
+		{"日本", token.Position{Offset: 10, Line: 1, Column: 9, ByteColumn: 11}, token.Position{Offset: 18, Line: 1, Column: 13, ByteColumn: 19}},
+		{"变量", token.Position{Offset: 20, Line: 2, Column: 2, ByteColumn: 2}, token.Position{Offset: 26, Line: 2, Column: 4, ByteColumn: 8}},
+	}
+	lexer := New(input)
+	for i, tt := range tests {
+		tok, _ := lexer.NextToken()
+		if tok.Literal != tt.expectedLiteral {
+			t.Fatalf("tests[%d] - literal wrong. expected=%q, got=%q",
+				i, tt.expectedLiteral, tok.Literal)
+		}
+		if tok.Span.Start != tt.expectedStart || tok.Span.End != tt.expectedEnd {
+			t.Fatalf("tests[%d] - span of %q wrong. expected=%+v-%+v, got=%+v-%+v",
+				i, tok.Literal, tt.expectedStart, tt.expectedEnd, tok.Span.Start, tok.Span.End)
+		}
+	}
+}
+
+func TestLexer_NextToken_ShouldSkipPastIllegalRunes(t *testing.T) {
+	tests := []struct {
+		input         string
+		expectedError string
+		expectedNext  string
+	}{
+		{"€ x", "1:1: illegal token €", "x"},
+		{"١ x", "1:1: illegal token ١", "x"},
+		{"\xff x", "1:1: invalid UTF-8 byte 0xff", "x"},
+		{"\"a\xffb\" x", "1:1: invalid UTF-8 byte 0xff in string", "x"},
+	}
+	for i, tt := range tests {
+		lexer := New(tt.input)
+		tok, err := lexer.NextToken()
+		if tok.Class != token.ILLEGAL {
+			t.Fatalf("tests[%d] - tokentype wrong. expected=%q, got=%q",
+				i, token.ILLEGAL, tok.Class)
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), tt.expectedError) {
+			t.Fatalf("tests[%d] - error wrong. expected=%q, got=%v",
+				i, tt.expectedError, err)
+		}
+		if next, _ := lexer.NextToken(); next.Literal != tt.expectedNext {
+			t.Fatalf("tests[%d] - next literal wrong. expected=%q, got=%q",
+				i, tt.expectedNext, next.Literal)
+		}
+	}
+}
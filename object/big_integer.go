@@ -0,0 +1,47 @@
+package object
+
+import "math/big"
+
+// BigInteger is an integer that does not fit in an Int. It has the same
+// INTEGER type as Integer, and NewInteger only makes one when it has to, so
+// a value always has exactly one representation.
+type BigInteger struct {
+	Value *big.Int
+}
+
+func (b *BigInteger) Type() ObjectType {
+	return INTEGER_OBJ
+}
+
+func (b *BigInteger) Inspect() string {
+	return b.Value.String()
+}
+
+// bigIntegerKey keeps big integer hash keys apart from small ones. They can
+// never be equal, since no value has both representations.
+const bigIntegerKey = "BIG_INTEGER"
+
+func (b *BigInteger) HashKey() HashKey {
+	return HashKey{Type: bigIntegerKey, Text: b.Value.String()}
+}
+
+// NewInteger returns value as an Integer if it fits and as a BigInteger
+// otherwise.
+func NewInteger(value *big.Int) Object {
+	if value.IsInt64() && int64(int(value.Int64())) == value.Int64() {
+		return &Integer{Value: int(value.Int64())}
+	}
+	return &BigInteger{Value: value}
+}
+
+// ToBig returns the value of an Integer or a BigInteger as a big.Int, which
+// the caller may modify.
+func ToBig(obj Object) (*big.Int, bool) {
+	switch integer := obj.(type) {
+	case *Integer:
+		return big.NewInt(int64(integer.Value)), true
+	case *BigInteger:
+		return new(big.Int).Set(integer.Value), true
+	}
+	return nil, false
+}
@@ -0,0 +1,53 @@
+package object
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewInteger(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		value    *big.Int
+		expected string
+		isBig    bool
+	}{
+		{big.NewInt(42), "42", false},
+		{big.NewInt(-9223372036854775808), "-9223372036854775808", false},
+		{new(big.Int).Add(big.NewInt(9223372036854775807), big.NewInt(1)), "9223372036854775808", true},
+		{huge, "123456789012345678901234567890", true},
+	}
+	for i, tt := range tests {
+		obj := NewInteger(tt.value)
+		if _, isBig := obj.(*BigInteger); isBig != tt.isBig {
+			t.Errorf("tests[%d] - representation wrong. got=%T", i, obj)
+		}
+		if obj.Type() != INTEGER_OBJ {
+			t.Errorf("tests[%d] - type wrong. expected=%q, got=%q", i, INTEGER_OBJ, obj.Type())
+		}
+		if obj.Inspect() != tt.expected {
+			t.Errorf("tests[%d] - Inspect() wrong. expected=%q, got=%q", i, tt.expected, obj.Inspect())
+		}
+	}
+}
+
+func TestBigInteger_EqualsAndHashKey(t *testing.T) {
+	a, _ := new(big.Int).SetString("99999999999999999999", 10)
+	b, _ := new(big.Int).SetString("99999999999999999999", 10)
+	left := &BigInteger{Value: a}
+	right := &BigInteger{Value: b}
+	negative := &BigInteger{Value: new(big.Int).Neg(a)}
+
+	if !Equals(left, right) {
+		t.Errorf("equal big integers are not Equal")
+	}
+	if left.HashKey() != right.HashKey() {
+		t.Errorf("equal big integers have different hash keys")
+	}
+	if Equals(left, negative) || left.HashKey() == negative.HashKey() {
+		t.Errorf("a big integer equals its negation")
+	}
+	if Equals(left, &Integer{Value: 1}) || Equals(&Integer{Value: 1}, left) {
+		t.Errorf("a big integer equals a small one")
+	}
+}
@@ -0,0 +1,45 @@
+package object
+
+// Environment maps names to values. Lookups that miss fall through to the
+// outer environment, which gives lexical scoping for blocks and closures.
+type Environment struct {
+	store map[string]Object
+	outer *Environment
+}
+
+func NewEnvironment() *Environment {
+	return &Environment{store: make(map[string]Object)}
+}
+
+func NewEnclosedEnvironment(outer *Environment) *Environment {
+	env := NewEnvironment()
+	env.outer = outer
+	return env
+}
+
+func (env *Environment) Get(name string) (Object, bool) {
+	obj, ok := env.store[name]
+	if !ok && env.outer != nil {
+		return env.outer.Get(name)
+	}
+	return obj, ok
+}
+
+// Set binds name in this environment, shadowing any outer binding.
+func (env *Environment) Set(name string, value Object) Object {
+	env.store[name] = value
+	return value
+}
+
+// Assign rebinds name in the innermost environment that has it, so that
+// assignments inside blocks and closures update the variable they see. It
+// reports false if name is not bound anywhere.
+func (env *Environment) Assign(name string, value Object) bool {
+	for scope := env; scope != nil; scope = scope.outer {
+		if _, ok := scope.store[name]; ok {
+			scope.store[name] = value
+			return true
+		}
+	}
+	return false
+}
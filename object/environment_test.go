@@ -0,0 +1,72 @@
+package object
+
+import "testing"
+
+func TestEnvironment_GetFallsThroughToOuter(t *testing.T) {
+	outer := NewEnvironment()
+	outer.Set("x", &Integer{Value: 1})
+	outer.Set("y", &Integer{Value: 2})
+
+	inner := NewEnclosedEnvironment(outer)
+	inner.Set("y", &Integer{Value: 20})
+
+	tests := []struct {
+		env      *Environment
+		name     string
+		expected int
+	}{
+		{inner, "x", 1},
+		{inner, "y", 20},
+		{outer, "y", 2},
+	}
+	for i, tt := range tests {
+		obj, ok := tt.env.Get(tt.name)
+		if !ok {
+			t.Fatalf("tests[%d] - %s is not bound", i, tt.name)
+		}
+		if obj.(*Integer).Value != tt.expected {
+			t.Fatalf("tests[%d] - %s wrong. expected=%d, got=%s",
+				i, tt.name, tt.expected, obj.Inspect())
+		}
+	}
+
+	if _, ok := outer.Get("z"); ok {
+		t.Fatalf("unbound name is found")
+	}
+}
+
+func TestEnvironment_AssignUpdatesNearestBinding(t *testing.T) {
+	outer := NewEnvironment()
+	outer.Set("x", &Integer{Value: 1})
+	outer.Set("y", &Integer{Value: 2})
+
+	inner := NewEnclosedEnvironment(outer)
+	inner.Set("y", &Integer{Value: 20})
+
+	if !inner.Assign("x", &Integer{Value: 10}) || !inner.Assign("y", &Integer{Value: 200}) {
+		t.Fatalf("Assign did not find a bound name")
+	}
+	if inner.Assign("z", &Integer{Value: 0}) {
+		t.Fatalf("Assign bound an undeclared name")
+	}
+	if _, ok := inner.Get("z"); ok {
+		t.Fatalf("failed Assign left a binding behind")
+	}
+
+	tests := []struct {
+		env      *Environment
+		name     string
+		expected int
+	}{
+		{outer, "x", 10},
+		{inner, "y", 200},
+		{outer, "y", 2},
+	}
+	for i, tt := range tests {
+		obj, _ := tt.env.Get(tt.name)
+		if obj.(*Integer).Value != tt.expected {
+			t.Fatalf("tests[%d] - %s wrong. expected=%d, got=%s",
+				i, tt.name, tt.expected, obj.Inspect())
+		}
+	}
+}
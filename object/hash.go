@@ -0,0 +1,89 @@
+package object
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HashKey identifies a hashable value. Two values have the same HashKey
+// exactly when they are equal under Equals: integers and booleans are keyed
+// by their bits, strings and big integers by their text, so that different
+// keys can never collide.
+type HashKey struct {
+	Type  ObjectType
+	Value uint64
+	Text  string
+}
+
+// Hashable is implemented by the values that can be used as hash keys:
+// integers, booleans and strings.
+type Hashable interface {
+	Object
+	HashKey() HashKey
+}
+
+func (i *Integer) HashKey() HashKey {
+	return HashKey{Type: i.Type(), Value: uint64(i.Value)}
+}
+
+func (b *Boolean) HashKey() HashKey {
+	var value uint64
+	if b.Value {
+		value = 1
+	}
+	return HashKey{Type: b.Type(), Value: value}
+}
+
+func (s *String) HashKey() HashKey {
+	return HashKey{Type: s.Type(), Text: s.Value}
+}
+
+type HashPair struct {
+	Key   Object
+	Value Object
+}
+
+// Hash maps hashable keys to values and remembers insertion order, so that
+// Inspect and iteration are deterministic.
+type Hash struct {
+	Pairs map[HashKey]HashPair
+	order []HashKey
+}
+
+func NewHash() *Hash {
+	return &Hash{Pairs: make(map[HashKey]HashPair)}
+}
+
+func (h *Hash) Type() ObjectType {
+	return HASH_OBJ
+}
+
+func (h *Hash) Inspect() string {
+	var pairs []string
+	for _, pair := range h.Ordered() {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", pair.Key.Inspect(), pair.Value.Inspect()))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
+}
+
+func (h *Hash) Set(key Hashable, value Object) {
+	hashKey := key.HashKey()
+	if _, ok := h.Pairs[hashKey]; !ok {
+		h.order = append(h.order, hashKey)
+	}
+	h.Pairs[hashKey] = HashPair{Key: key, Value: value}
+}
+
+func (h *Hash) Get(key Hashable) (Object, bool) {
+	pair, ok := h.Pairs[key.HashKey()]
+	return pair.Value, ok
+}
+
+// Ordered returns the pairs in the order their keys were first set.
+func (h *Hash) Ordered() []HashPair {
+	pairs := make([]HashPair, 0, len(h.order))
+	for _, key := range h.order {
+		pairs = append(pairs, h.Pairs[key])
+	}
+	return pairs
+}
@@ -0,0 +1,65 @@
+package object
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestHashKey(t *testing.T) {
+	tests := []struct {
+		left     Hashable
+		right    Hashable
+		expected bool
+	}{
+		{&String{Value: "Hello World"}, &String{Value: "Hello World"}, true},
+		{&String{Value: "Hello World"}, &String{Value: "My name is johnny"}, false},
+		{&Integer{Value: 1}, &Integer{Value: 1}, true},
+		{&Integer{Value: 1}, TRUE, false},
+		{&Integer{Value: 0}, FALSE, false},
+		{TRUE, &Boolean{Value: true}, true},
+		{&String{Value: "1"}, &Integer{Value: 1}, false},
+		{&String{Value: ""}, &String{Value: "\x00"}, false},
+		{&String{Value: "a"}, &String{Value: "a\x00"}, false},
+	}
+	for i, tt := range tests {
+		if actual := tt.left.HashKey() == tt.right.HashKey(); actual != tt.expected {
+			t.Errorf("tests[%d] - %s and %s share a hash key: %t, expected %t",
+				i, tt.left.Inspect(), tt.right.Inspect(), actual, tt.expected)
+		}
+	}
+}
+
+func TestHash_KeepsInsertionOrder(t *testing.T) {
+	hash := NewHash()
+	hash.Set(&String{Value: "b"}, &Integer{Value: 1})
+	hash.Set(&Integer{Value: 2}, &Integer{Value: 2})
+	hash.Set(&String{Value: "a"}, &Integer{Value: 3})
+	hash.Set(&String{Value: "b"}, &Integer{Value: 4})
+
+	if hash.Inspect() != "{b: 4, 2: 2, a: 3}" {
+		t.Fatalf("Inspect() wrong. got=%q", hash.Inspect())
+	}
+	if value, ok := hash.Get(&String{Value: "a"}); !ok || value.(*Integer).Value != 3 {
+		t.Fatalf("Get() wrong. got=%v, %t", value, ok)
+	}
+	if _, ok := hash.Get(&String{Value: "z"}); ok {
+		t.Fatalf("Get() found a missing key")
+	}
+}
+
+func TestHash_KeepsEveryStringKeyApart(t *testing.T) {
+	hash := NewHash()
+	const n = 100000
+	for i := 0; i < n; i++ {
+		hash.Set(&String{Value: strconv.Itoa(i)}, &Integer{Value: i})
+	}
+	if len(hash.Pairs) != n {
+		t.Fatalf("string keys collided. got %d pairs, expected %d", len(hash.Pairs), n)
+	}
+	for i := 0; i < n; i++ {
+		value, ok := hash.Get(&String{Value: strconv.Itoa(i)})
+		if !ok || value.(*Integer).Value != i {
+			t.Fatalf("Get(%q) wrong. got=%v, %t", strconv.Itoa(i), value, ok)
+		}
+	}
+}
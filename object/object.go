@@ -0,0 +1,259 @@
+package object
+
+import (
+	"fmt"
+	"interpreter/ast"
+	"strconv"
+	"strings"
+)
+
+// ObjectType tags every runtime value so that operators can dispatch on it.
+type ObjectType string
+
+const (
+	INTEGER_OBJ      = "INTEGER"
+	FLOAT_OBJ        = "FLOAT"
+	BOOLEAN_OBJ      = "BOOLEAN"
+	STRING_OBJ       = "STRING"
+	ARRAY_OBJ        = "ARRAY"
+	HASH_OBJ         = "HASH"
+	NULL_OBJ         = "NULL"
+	RETURN_VALUE_OBJ = "RETURN_VALUE"
+	BREAK_OBJ        = "BREAK"
+	CONTINUE_OBJ     = "CONTINUE"
+	TAIL_CALL_OBJ    = "TAIL_CALL"
+	FUNCTION_OBJ     = "FUNCTION"
+	ERROR_OBJ        = "ERROR"
+)
+
+type Object interface {
+	Type() ObjectType
+	Inspect() string
+}
+
+var (
+	TRUE  = &Boolean{Value: true}
+	FALSE = &Boolean{Value: false}
+	NULL  = &Null{}
+
+	BREAK    = &Break{}
+	CONTINUE = &Continue{}
+)
+
+// NativeBoolToBooleanObject maps a Go bool onto the TRUE and FALSE
+// singletons, so booleans can be compared by identity.
+func NativeBoolToBooleanObject(value bool) *Boolean {
+	if value {
+		return TRUE
+	}
+	return FALSE
+}
+
+// Equals reports whether two objects hold the same value. Numbers, booleans
+// and strings compare by value, everything else compares by identity.
+func Equals(left, right Object) bool {
+	if left.Type() != right.Type() {
+		return false
+	}
+	switch l := left.(type) {
+	case *Integer:
+		r, ok := right.(*Integer)
+		return ok && l.Value == r.Value
+	case *BigInteger:
+		r, ok := right.(*BigInteger)
+		return ok && l.Value.Cmp(r.Value) == 0
+	case *Float:
+		return l.Value == right.(*Float).Value
+	case *Boolean:
+		return l.Value == right.(*Boolean).Value
+	case *String:
+		return l.Value == right.(*String).Value
+	case *Null:
+		return true
+	}
+	return left == right
+}
+
+type Integer struct {
+	Value int
+}
+
+func (i *Integer) Type() ObjectType {
+	return INTEGER_OBJ
+}
+
+func (i *Integer) Inspect() string {
+	return fmt.Sprintf("%d", i.Value)
+}
+
+type Float struct {
+	Value float64
+}
+
+func (f *Float) Type() ObjectType {
+	return FLOAT_OBJ
+}
+
+// Inspect always shows a decimal point or an exponent so that floats can be
+// told apart from integers.
+func (f *Float) Inspect() string {
+	s := strconv.FormatFloat(f.Value, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+	return s
+}
+
+type Boolean struct {
+	Value bool
+}
+
+func (b *Boolean) Type() ObjectType {
+	return BOOLEAN_OBJ
+}
+
+func (b *Boolean) Inspect() string {
+	return fmt.Sprintf("%t", b.Value)
+}
+
+type String struct {
+	Value string
+}
+
+func (s *String) Type() ObjectType {
+	return STRING_OBJ
+}
+
+func (s *String) Inspect() string {
+	return s.Value
+}
+
+type Array struct {
+	Elements []Object
+}
+
+func (a *Array) Type() ObjectType {
+	return ARRAY_OBJ
+}
+
+func (a *Array) Inspect() string {
+	var elements []string
+	for _, e := range a.Elements {
+		elements = append(elements, e.Inspect())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+}
+
+type Null struct{}
+
+func (n *Null) Type() ObjectType {
+	return NULL_OBJ
+}
+
+func (n *Null) Inspect() string {
+	return "null"
+}
+
+// ReturnValue wraps the value of a return statement while it unwinds
+// through enclosing blocks.
+type ReturnValue struct {
+	Value Object
+}
+
+func (r *ReturnValue) Type() ObjectType {
+	return RETURN_VALUE_OBJ
+}
+
+func (r *ReturnValue) Inspect() string {
+	return r.Value.Inspect()
+}
+
+// TailCall is a call in tail position with its arguments bound in Env but
+// its body not run yet. The function it is in returns it and the caller
+// runs it in its place, so that tail recursion takes no Go stack.
+type TailCall struct {
+	Function *Function
+	Env      *Environment
+}
+
+func (t *TailCall) Type() ObjectType {
+	return TAIL_CALL_OBJ
+}
+
+func (t *TailCall) Inspect() string {
+	return "tail call of " + t.Function.Inspect()
+}
+
+// Break and Continue unwind through the blocks of a loop body up to the
+// loop, like ReturnValue does up to the function.
+type Break struct{}
+
+func (b *Break) Type() ObjectType {
+	return BREAK_OBJ
+}
+
+func (b *Break) Inspect() string {
+	return "break"
+}
+
+type Continue struct{}
+
+func (c *Continue) Type() ObjectType {
+	return CONTINUE_OBJ
+}
+
+func (c *Continue) Inspect() string {
+	return "continue"
+}
+
+// Function is a closure: Env is the environment the function literal was
+// evaluated in. Name is empty for anonymous functions.
+type Function struct {
+	Name       string
+	Parameters []ast.Parameter
+	Body       ast.BlockStatement
+	Env        *Environment
+}
+
+func (f *Function) Type() ObjectType {
+	return FUNCTION_OBJ
+}
+
+func (f *Function) Inspect() string {
+	var params []string
+	for _, p := range f.Parameters {
+		params = append(params, p.String())
+	}
+	name := f.Name
+	if name != "" {
+		name = " " + name
+	}
+	return fmt.Sprintf(
+		"fun%s(%s) %s",
+		name,
+		strings.Join(params, ", "),
+		f.Body.String(),
+	)
+}
+
+// Error is a runtime error. It is both an Object and a Go error, so the
+// evaluator can hand it back through its error return.
+type Error struct {
+	Message string
+}
+
+func NewError(format string, a ...any) *Error {
+	return &Error{Message: fmt.Sprintf(format, a...)}
+}
+
+func (e *Error) Type() ObjectType {
+	return ERROR_OBJ
+}
+
+func (e *Error) Inspect() string {
+	return "ERROR: " + e.Message
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
@@ -0,0 +1,71 @@
+package object
+
+import "testing"
+
+func TestEquals(t *testing.T) {
+	function := &Function{Name: "f"}
+	tests := []struct {
+		left     Object
+		right    Object
+		expected bool
+	}{
+		{&Integer{Value: 1}, &Integer{Value: 1}, true},
+		{&Integer{Value: 1}, &Integer{Value: 2}, false},
+		{TRUE, &Boolean{Value: true}, true},
+		{TRUE, FALSE, false},
+		{NULL, &Null{}, true},
+		{&String{Value: "a"}, &String{Value: "a"}, true},
+		{&String{Value: "a"}, &String{Value: "b"}, false},
+		{&Float{Value: 0.5}, &Float{Value: 0.5}, true},
+		{&Float{Value: 1}, &Integer{Value: 1}, false},
+		{&Integer{Value: 1}, TRUE, false},
+		{NULL, FALSE, false},
+		{function, function, true},
+		{function, &Function{Name: "f"}, false},
+	}
+	for i, tt := range tests {
+		if actual := Equals(tt.left, tt.right); actual != tt.expected {
+			t.Errorf("tests[%d] - Equals(%s, %s) wrong. expected=%t, got=%t",
+				i, tt.left.Inspect(), tt.right.Inspect(), tt.expected, actual)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	tests := []struct {
+		obj          Object
+		expectedType ObjectType
+		expected     string
+	}{
+		{&Integer{Value: -42}, INTEGER_OBJ, "-42"},
+		{&Float{Value: 3.25}, FLOAT_OBJ, "3.25"},
+		{&Float{Value: -2}, FLOAT_OBJ, "-2.0"},
+		{&Float{Value: 1e21}, FLOAT_OBJ, "1e+21"},
+		{TRUE, BOOLEAN_OBJ, "true"},
+		{FALSE, BOOLEAN_OBJ, "false"},
+		{NULL, NULL_OBJ, "null"},
+		{&String{Value: "hi\n"}, STRING_OBJ, "hi\n"},
+		{&ReturnValue{Value: &Integer{Value: 7}}, RETURN_VALUE_OBJ, "7"},
+		{NewError("type mismatch: %s + %s", "INTEGER", "BOOLEAN"),
+			ERROR_OBJ, "ERROR: type mismatch: INTEGER + BOOLEAN"},
+	}
+	for i, tt := range tests {
+		if tt.obj.Type() != tt.expectedType {
+			t.Errorf("tests[%d] - type wrong. expected=%q, got=%q",
+				i, tt.expectedType, tt.obj.Type())
+		}
+		if tt.obj.Inspect() != tt.expected {
+			t.Errorf("tests[%d] - Inspect() wrong. expected=%q, got=%q",
+				i, tt.expected, tt.obj.Inspect())
+		}
+	}
+}
+
+func TestNativeBoolToBooleanObject(t *testing.T) {
+	if NativeBoolToBooleanObject(true) != TRUE {
+		t.Fatalf("true is not mapped to the TRUE singleton")
+	}
+	if NativeBoolToBooleanObject(false) != FALSE {
+		t.Fatalf("false is not mapped to the FALSE singleton")
+	}
+}
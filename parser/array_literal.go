@@ -0,0 +1,22 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/token"
+)
+
+func (parser *Parser) tryArrayLiteral() ast.IExpr {
+	array := ast.ArrayLiteral{OpeningBracket: parser.currentToken}
+	parser.eatToken()
+	array.Elements, array.ClosingBracket = parser.tryExpressionList(token.RBRACKET, parser.tryListElement)
+	return array
+}
+
+func (parser *Parser) tryIndexExpr(left ast.IExpr) ast.IExpr {
+	expr := ast.IndexExpression{OpeningBracket: parser.currentToken, Left: left}
+	parser.eatToken()
+	expr.Index = parser.tryExpression(LOWEST)
+	expr.ClosingBracket = parser.currentToken
+	parser.tryToken(token.RBRACKET)
+	return expr
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/token"
+)
+
+// startsArrowFunction tells, with the current token at `(`, whether the
+// parentheses hold the parameters of an arrow function rather than a
+// grouped expression: whether the matching `)` is followed by `=>`.
+func (parser *Parser) startsArrowFunction() bool {
+	// parameter lists start with a name or `...` or are empty, anything else
+	// is a group and needs no further lookahead
+	if !parser.nextTokenIs(token.IDENT) && !parser.nextTokenIs(token.RPAREN) &&
+		!parser.nextTokenIs(token.ELLIPSIS) {
+		return false
+	}
+	depth := 0
+	ahead := parser.nextToken
+	for n := 1; ; n++ {
+		switch ahead.Class {
+		case token.LPAREN:
+			depth++
+		case token.RPAREN:
+			if depth == 0 {
+				after, _ := parser.lexer.Peek(n)
+				return after.Class == token.ARROW
+			}
+			depth--
+		case token.EOF:
+			return false
+		}
+		ahead, _ = parser.lexer.Peek(n)
+	}
+}
+
+// tryArrowFunction parses `(a, b) => a + b` and `x => x * 2` into a
+// function literal whose body returns the expression. A `{` after the arrow
+// opens a block body instead, unless it starts a hash literal.
+func (parser *Parser) tryArrowFunction() ast.IExpr {
+	literal := ast.FunctionLiteral{Token: parser.currentToken}
+	if parser.currentTokenIs(token.IDENT) {
+		parameter, _ := parser.tryIdentExpr()
+		parser.eatToken()
+		literal.Parameters = []ast.Parameter{{Name: parameter}}
+	} else {
+		parameters, ok := parser.tryFunctionParameters()
+		if !ok {
+			return nil
+		}
+		literal.Parameters = parameters
+	}
+
+	arrow := parser.currentToken
+	if !parser.tryToken(token.ARROW) {
+		return nil
+	}
+	body, ok := parser.tryFunctionBody(func() (ast.BlockStatement, bool) {
+		if parser.currentTokenIs(token.LBRACE) && parser.peekTokenAfterNext().Class != token.COLON {
+			return parser.tryBlock()
+		}
+		return parser.tryArrowBody(arrow)
+	})
+	if !ok {
+		return nil
+	}
+	literal.Body = body
+	return literal
+}
+
+// tryArrowBody parses the expression after `=>` into a block holding just
+// that expression. The block has no brackets: it opens with the arrow and
+// closes, with no width, where the expression ends.
+func (parser *Parser) tryArrowBody(arrow token.Token) (ast.BlockStatement, bool) {
+	stmt := ast.ExpressionStatement{Token: parser.currentToken}
+	stmt.Expression = parser.tryExpression(LOWEST)
+	if stmt.Expression == nil {
+		return ast.BlockStatement{}, false
+	}
+	end := stmt.Expression.Span().End
+	return ast.BlockStatement{
+		OpeningBracket: arrow,
+		ClosingBracket: token.Token{Class: token.RBRACE, Span: token.Span{Start: end, End: end}},
+		Statements:     []ast.Statement{&stmt},
+	}, true
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseArrowFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"x => x * 2", "(x) => (x * 2)"},
+		{"(a, b) => a + b", "(a, b) => (a + b)"},
+		{"() => 1", "() => 1"},
+		{"(x) => x", "(x) => x"},
+		{"x => y => x + y", "(x) => (y) => (x + y)"},
+		{"map(xs, x => x + 1)", "map(xs, (x) => (x + 1))"},
+		{"reduce(xs, (acc, x) => acc + x, 0)", "reduce(xs, (acc, x) => (acc + x), 0)"},
+		{"(x => x * 2)(5)", "((x) => (x * 2))(5)"},
+		{"let f = x => x; f(1)", "let f = (x) => x;f(1)"},
+		{"(a, b) => { let c = a + b; c }", "(a, b) => {\n  let c = (a + b);\n  c\n}"},
+		{"x => {}", "(x) => {\n}"},
+		{`x => {"value": x}`, `(x) => {"value": x}`},
+		// plain groups still parse as groups
+		{"(a + b) * c", "((a + b) * c)"},
+		{"(a) * 2", "(a * 2)"},
+		{"(f(a, b)) + 1", "(f(a, b) + 1)"},
+		{"a >= b", "(a >= b)"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_parseArrowFunctionNode(t *testing.T) {
+	l := lexer.New("(a, b) => a + b")
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	function, ok := stmt.Expression.(ast.FunctionLiteral)
+	if !ok {
+		t.Fatalf("stmt.Expression is not ast.FunctionLiteral. got=%T", stmt.Expression)
+	}
+	if function.FunctionName.Literal != "" {
+		t.Errorf("arrow function has a name. got=%q", function.FunctionName.Literal)
+	}
+	if len(function.Parameters) != 2 {
+		t.Fatalf("function literal parameters wrong. want 2, got=%d", len(function.Parameters))
+	}
+	testLiteralExpression(t, &function.Parameters[0].Name, "a")
+	testLiteralExpression(t, &function.Parameters[1].Name, "b")
+	if len(function.Body.Statements) != 1 {
+		t.Fatalf("function.Body.Statements has not 1 statements. got=%d", len(function.Body.Statements))
+	}
+	body, ok := function.Body.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("function body stmt is not ast.ExpressionStatement. got=%T", function.Body.Statements[0])
+	}
+	testInfixExpression(t, body.Expression, "a", "+", "b")
+	if function.Span().String() != "1:1-1:16" {
+		t.Errorf("span wrong. expected=1:1-1:16, got=%v", function.Span())
+	}
+}
+
+func Test_parseMalformedArrowFunctions(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"(a, 1) => a", `1:5: expected class IDENT, got INT "1"`},
+		{"x =>", `1:5: no prefix parse function for EOF "EOF"`},
+		{"while (true) { f = () => { break } }", "1:28: break outside of a loop"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%q: no error reported", tt.input)
+		}
+		if errors[0].Error() != tt.expectedMessage {
+			t.Errorf("%q: message wrong. expected=%q, got=%q", tt.input, tt.expectedMessage, errors[0].Error())
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+)
+
+// tryAssignExpr parses the right hand side of `target = value` and its
+// compound forms. Only identifiers can be assigned to.
+func (parser *Parser) tryAssignExpr(target ast.IExpr) ast.IExpr {
+	expr := &ast.AssignExpression{
+		Operator: parser.currentToken,
+		Target:   target,
+	}
+	_, ok := target.(*ast.Identifier)
+	if !ok && target != nil {
+		parser.addError(diagnostics.New(
+			InvalidAssignmentTarget, target.Span(),
+			"can not assign to %s", target,
+		).WithHint("only variables can be assigned to"))
+	}
+
+	parser.eatToken()
+	// assignments are right associative, a = b = 1 sets both
+	expr.Value = parser.tryExpression(ASSIGN - 1)
+	if !ok {
+		return nil
+	}
+	return expr
+}
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseAssignExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"x = 1", "(x = 1)"},
+		{"x = 1 + 2 * 3", "(x = (1 + (2 * 3)))"},
+		{"a = b = 1", "(a = (b = 1))"},
+		{"x += 1", "(x += 1)"},
+		{"x -= y || z", "(x -= (y || z))"},
+		{"x *= 2; x /= 2; x %= 2; x **= 2", "(x *= 2)(x /= 2)(x %= 2)(x **= 2)"},
+		{"let a = b = 2", "let a = (b = 2);"},
+		{"f(x = 1)", "f((x = 1))"},
+		{"if (x = y) { x }", "if ((x = y)) {\n  x\n}"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_parseAssignExpressionNode(t *testing.T) {
+	l := lexer.New("total += 5")
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	assign, ok := stmt.Expression.(*ast.AssignExpression)
+	if !ok {
+		t.Fatalf("exp not *ast.AssignExpression. got=%T", stmt.Expression)
+	}
+	if assign.Operator.Literal != "+=" {
+		t.Errorf("operator wrong. expected=%q, got=%q", "+=", assign.Operator.Literal)
+	}
+	testIdentifier(t, assign.Target, "total")
+	testIntegerLiteral(t, assign.Value, 5)
+	if assign.Span().String() != "1:1-1:11" {
+		t.Errorf("span wrong. expected=1:1-1:11, got=%v", assign.Span())
+	}
+}
+
+func Test_parseInvalidAssignmentTargets(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"1 = 2", "1:1: can not assign to 1"},
+		{"f() = 1", "1:1: can not assign to f()"},
+		{"a[0] = 1", "1:1: can not assign to (a[0])"},
+		{"(a + b) += 1", "1:2: can not assign to (a + b)"},
+		{"a + b = 1", "1:1: can not assign to (a + b)"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got=%d (%v)", tt.input, len(errors), errors)
+		}
+		d, ok := errors[0].(diagnostics.Diagnostic)
+		if !ok || d.Code != InvalidAssignmentTarget {
+			t.Fatalf("%q: wrong error. got=%#v", tt.input, errors[0])
+		}
+		if d.Error() != tt.expectedMessage {
+			t.Errorf("%q: message wrong. expected=%q, got=%q", tt.input, tt.expectedMessage, d.Error())
+		}
+	}
+}
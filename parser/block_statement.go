@@ -2,21 +2,25 @@ package parser
 
 import (
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/token"
 )
 
 func (parser *Parser) tryBlockStatement() ast.IExpr {
+	block := ast.BlockStatement{OpeningBracket: parser.currentToken}
 	parser.eatToken()
-	block := ast.BlockStatement{
-		OpeningBracket: token.Token{
-			Literal: "{",
-			Class:   token.LBRACE,
-		},
-	}
 	for !parser.currentTokenIs(token.RBRACE) && !parser.currentTokenIs(token.EOF) {
-		stmt, _ := parser.tryStatement()
-		block.Statements = append(block.Statements, stmt)
-		parser.eatToken()
+		if stmt, ok := parser.tryStatementOrRecover(); ok {
+			block.Statements = append(block.Statements, stmt)
+		}
+	}
+	block.ClosingBracket = parser.currentToken
+	if parser.currentTokenIs(token.EOF) {
+		parser.addError(diagnostics.New(
+			UnexpectedToken, parser.currentToken.Span,
+			"expected class %v, got %v", token.RBRACE, token.EOF,
+		).WithHint("the block opened at %v is never closed", block.OpeningBracket.Span.Start))
+		return block
 	}
 	parser.eatToken()
 	return block
@@ -1,8 +1,8 @@
 package parser
 
 import (
-	"fmt"
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/token"
 )
 
@@ -21,6 +21,8 @@ func (parser *Parser) tryBooleanLiteralExpr() ast.IExpr {
 		}
 	}
 
-	parser.addError(fmt.Errorf("unknown boolean literal %s", t.Literal))
+	parser.addError(diagnostics.New(
+		MalformedBoolean, t.Span, "unknown boolean literal %s", t.Literal,
+	))
 	return nil
 }
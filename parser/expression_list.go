@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/token"
+)
+
+// tryExpressionList parses comma separated elements up to the end token,
+// which is eaten and returned so that callers can record where the list
+// closes. The opening token must already be eaten.
+func (parser *Parser) tryExpressionList(end token.Class, element func() ast.IExpr) ([]ast.IExpr, token.Token) {
+	var list []ast.IExpr
+
+	if !parser.currentTokenIs(end) {
+		list = append(list, element())
+		for parser.currentTokenIs(token.COMMA) {
+			parser.eatToken()
+			list = append(list, element())
+		}
+	}
+
+	closing := parser.currentToken
+	parser.tryToken(end)
+	return list, closing
+}
+
+// tryListElement parses an element of an array literal or an argument list:
+// an expression, or `...array` to put the elements of array there.
+func (parser *Parser) tryListElement() ast.IExpr {
+	if !parser.currentTokenIs(token.ELLIPSIS) {
+		return parser.tryExpression(LOWEST)
+	}
+	spread := ast.SpreadExpression{Token: parser.currentToken}
+	parser.eatToken()
+	spread.Value = parser.tryExpression(LOWEST)
+	return spread
+}
+
+// tryArgument parses an argument of a call, which is a list element or a
+// named argument `name: value`.
+func (parser *Parser) tryArgument() ast.IExpr {
+	if !parser.currentTokenIs(token.IDENT) || !parser.nextTokenIs(token.COLON) {
+		return parser.tryListElement()
+	}
+	name := &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Literal}
+	parser.eatToken()
+	parser.eatToken()
+	return ast.NamedArgument{Name: name, Value: parser.tryExpression(LOWEST)}
+}
+
+// checkArguments reports named arguments given twice and positional ones
+// after a named one.
+func (parser *Parser) checkArguments(arguments []ast.IExpr) {
+	named := make(map[string]bool)
+	for _, argument := range arguments {
+		namedArgument, ok := argument.(ast.NamedArgument)
+		switch {
+		case ok && named[namedArgument.Name.Value]:
+			parser.addError(diagnostics.New(
+				InvalidArgument, namedArgument.Name.Span(),
+				"duplicate argument %s", namedArgument.Name.Value,
+			))
+			return
+		case ok:
+			named[namedArgument.Name.Value] = true
+		case len(named) > 0 && argument != nil:
+			parser.addError(diagnostics.New(
+				InvalidArgument, argument.Span(),
+				"positional argument %s follows a named argument", argument,
+			).WithHint("pass named arguments after all the positional ones"))
+			return
+		}
+	}
+}
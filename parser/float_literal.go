@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"errors"
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/token"
+	"strconv"
+)
+
+func (parser *Parser) tryFloatLiteralExpr() ast.IExpr {
+	t := parser.currentToken
+	parser.eatToken()
+
+	number, err := strconv.ParseFloat(t.Literal, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		parser.addError(diagnostics.New(
+			NumberOverflow, t.Span,
+			"float %s is out of range", t.Literal,
+		))
+	} else if err != nil {
+		parser.addError(diagnostics.New(
+			MalformedFloat, t.Span,
+			"%s can not be parsed as a float", t.Literal,
+		))
+	}
+	if err != nil {
+		return &ast.FloatLiteral{
+			Token: token.Token{Class: token.ILLEGAL, Literal: t.Literal, Span: t.Span},
+			Value: 0,
+		}
+	}
+	return &ast.FloatLiteral{
+		Token: t,
+		Value: number,
+	}
+}
@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseNumberLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"0xFF", 255},
+		{"0o17", 15},
+		{"0b1010", 10},
+		{"1_000_000", 1000000},
+		{"0", 0},
+		{"3.14", 3.14},
+		{"1e-9", 1e-9},
+		{"1_000.5", 1000.5},
+		{"0.5", 0.5},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		expr := program.Statements[0].(*ast.ExpressionStatement).Expression
+		switch expected := tt.expected.(type) {
+		case int:
+			literal, ok := expr.(*ast.IntegerLiteral)
+			if !ok {
+				t.Fatalf("%q: exp not *ast.IntegerLiteral. got=%T", tt.input, expr)
+			}
+			if literal.Value != expected {
+				t.Errorf("%q: literal.Value wrong. expected=%d, got=%d", tt.input, expected, literal.Value)
+			}
+		case float64:
+			literal, ok := expr.(*ast.FloatLiteral)
+			if !ok {
+				t.Fatalf("%q: exp not *ast.FloatLiteral. got=%T", tt.input, expr)
+			}
+			if literal.Value != expected {
+				t.Errorf("%q: literal.Value wrong. expected=%g, got=%g", tt.input, expected, literal.Value)
+			}
+		}
+		if expr.String() != tt.input {
+			t.Errorf("%q: String() wrong. got=%q", tt.input, expr.String())
+		}
+	}
+}
+
+func Test_parseBigIntegerLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"9223372036854775808", "9223372036854775808"},
+		{"0x1_0000_0000_0000_0000", "18446744073709551616"},
+		{"123_456_789_012_345_678_901_234_567_890", "123456789012345678901234567890"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		expr := program.Statements[0].(*ast.ExpressionStatement).Expression
+		literal, ok := expr.(*ast.IntegerLiteral)
+		if !ok {
+			t.Fatalf("%q: exp not *ast.IntegerLiteral. got=%T", tt.input, expr)
+		}
+		if literal.Big == nil || literal.Big.String() != tt.expected {
+			t.Errorf("%q: literal.Big wrong. expected=%s, got=%v", tt.input, tt.expected, literal.Big)
+		}
+	}
+}
+
+func Test_parseMalformedNumbers(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedCode    string
+		expectedMessage string
+	}{
+		{"1e999", NumberOverflow, "1:1: float 1e999 is out of range"},
+		{"1 + 0x", MalformedInteger, "1:5: 0x can not be parsed as an integer"},
+		{"1__0", MalformedInteger, "1:1: 1__0 can not be parsed as an integer"},
+		{"0b102", MalformedInteger, "1:1: 0b102 can not be parsed as an integer"},
+		{"12abc", MalformedInteger, "1:1: 12abc can not be parsed as an integer"},
+		{"017", MalformedInteger, "1:1: leading zeros in decimal integer 017"},
+		{"1.5e", MalformedFloat, "1:1: 1.5e can not be parsed as a float"},
+		{"1_.5", MalformedFloat, "1:1: 1_.5 can not be parsed as a float"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%q: no errors reported", tt.input)
+		}
+		d, ok := errors[0].(diagnostics.Diagnostic)
+		if !ok {
+			t.Fatalf("%q: error is not a diagnostics.Diagnostic. got=%T", tt.input, errors[0])
+		}
+		if d.Code != tt.expectedCode {
+			t.Errorf("%q: code wrong. expected=%s, got=%s", tt.input, tt.expectedCode, d.Code)
+		}
+		if d.Error() != tt.expectedMessage {
+			t.Errorf("%q: message wrong. expected=%q, got=%q", tt.input, tt.expectedMessage, d.Error())
+		}
+	}
+}
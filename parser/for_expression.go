@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/token"
+)
+
+// tryForExpr parses both `for (init; condition; update) { ... }` and
+// `for x in xs { ... }`, the latter also with parentheses around `x in xs`.
+func (parser *Parser) tryForExpr() ast.IExpr {
+	t := parser.currentToken
+	parser.eatToken()
+
+	if parser.currentTokenIs(token.IDENT) {
+		return parser.tryForInExpr(t, false)
+	}
+	if parser.currentTokenIs(token.LPAREN) && parser.nextTokenIs(token.IDENT) &&
+		parser.peekTokenAfterNext().Class == token.IN {
+		parser.eatToken()
+		return parser.tryForInExpr(t, true)
+	}
+
+	expr := ast.ForExpression{Token: t}
+	if !parser.tryToken(token.LPAREN) {
+		return nil
+	}
+	if !parser.currentTokenIs(token.SEMICOLON) {
+		init, ok := parser.tryForInit()
+		if !ok {
+			return nil
+		}
+		expr.Init = init
+	}
+	if !parser.tryToken(token.SEMICOLON) {
+		return nil
+	}
+	if !parser.currentTokenIs(token.SEMICOLON) {
+		expr.Condition = parser.tryExpression(LOWEST)
+	}
+	if !parser.tryToken(token.SEMICOLON) {
+		return nil
+	}
+	if !parser.currentTokenIs(token.RPAREN) {
+		expr.Update = parser.tryExpression(LOWEST)
+	}
+	if !parser.tryToken(token.RPAREN) {
+		return nil
+	}
+
+	body, ok := parser.tryLoopBody()
+	if !ok {
+		return nil
+	}
+	expr.Body = &body
+	return expr
+}
+
+// tryForInit parses the first clause of a C style for loop: a let statement
+// or an expression.
+func (parser *Parser) tryForInit() (ast.Statement, bool) {
+	if parser.currentTokenIs(token.LET) {
+		stmt, err := parser.tryLetStatement()
+		if err != nil {
+			parser.addError(err)
+			return nil, false
+		}
+		return &stmt, true
+	}
+	stmt, ok := parser.tryExpressionStatement()
+	if !ok {
+		return nil, false
+	}
+	return &stmt, true
+}
+
+func (parser *Parser) tryForInExpr(t token.Token, parenthesized bool) ast.IExpr {
+	expr := ast.ForInExpression{Token: t}
+	variable, err := parser.tryIdentExpr()
+	if err != nil {
+		return nil
+	}
+	expr.Variable = &variable
+	parser.eatToken()
+
+	if !parser.tryToken(token.IN) {
+		return nil
+	}
+	expr.Iterable = parser.tryExpression(LOWEST)
+	if parenthesized && !parser.tryToken(token.RPAREN) {
+		return nil
+	}
+
+	body, ok := parser.tryLoopBody()
+	if !ok {
+		return nil
+	}
+	expr.Body = &body
+	return expr
+}
@@ -6,21 +6,9 @@ import (
 )
 
 func (parser *Parser) tryCallExpr(callee ast.IExpr) ast.IExpr {
-	var parameters []ast.IExpr
+	call := ast.CallExpression{OpeningParen: parser.currentToken, Function: callee}
 	parser.eatToken()
-
-	if parser.nextTokenIs(token.RPAREN) {
-		parser.eatToken()
-		return ast.CallExpression{Function: callee, Parameters: parameters}
-	}
-
-	parameters = append(parameters, parser.tryExpression(LOWEST))
-	for parser.currentTokenIs(token.COMMA) {
-		parser.eatToken()
-		parameters = append(parameters, parser.tryExpression(LOWEST))
-	}
-
-	parser.eatToken()
-
-	return ast.CallExpression{Function: callee, Parameters: parameters}
+	call.Parameters, call.ClosingParen = parser.tryExpressionList(token.RPAREN, parser.tryArgument)
+	parser.checkArguments(call.Parameters)
+	return call
 }
@@ -2,16 +2,31 @@ package parser
 
 import (
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/token"
 )
 
 func (parser *Parser) tryFunctionLiteral() ast.IExpr {
 	t := parser.currentToken
 	parser.eatToken() // `fun` keyword
-	name, _ := parser.tryIdentExpr()
-	parser.eatToken() // identifier
-	parameters := parser.tryFunctionParameters()
-	b := (parser.tryBlockStatement()).(ast.BlockStatement)
+	// the name is optional, `fun(x) { x }` is anonymous
+	var name ast.Identifier
+	if !parser.currentTokenIs(token.LPAREN) {
+		var err error
+		name, err = parser.tryIdentExpr()
+		if err != nil {
+			return nil
+		}
+		parser.eatToken() // identifier
+	}
+	parameters, ok := parser.tryFunctionParameters()
+	if !ok {
+		return nil
+	}
+	b, ok := parser.tryFunctionBody(parser.tryBlock)
+	if !ok {
+		return nil
+	}
 	return ast.FunctionLiteral{
 		Token:        t,
 		FunctionName: name.Token,
@@ -20,15 +35,97 @@ func (parser *Parser) tryFunctionLiteral() ast.IExpr {
 	}
 }
 
-func (parser *Parser) tryFunctionParameters() []ast.Identifier {
-	var ans []ast.Identifier
-	parser.eatToken() // left parenthesis
-	for !parser.currentTokenIs(token.RPAREN) {
-		ans = append(ans, *parser.tryIdentifierExpr().(*ast.Identifier))
-		if parser.currentTokenIs(token.COMMA) {
-			parser.eatToken()
+// tryFunctionDeclaration parses `fun name(...) { ... }` at the start of a
+// statement. The declaration ends with its body, so that a `(` or `[` on the
+// next line starts a new statement instead of calling or indexing the
+// function; only anonymous functions can be called right where they are
+// written.
+func (parser *Parser) tryFunctionDeclaration() (ast.Statement, error) {
+	stmt := ast.ExpressionStatement{Token: parser.currentToken}
+	stmt.Expression = parser.tryFunctionLiteral()
+	if stmt.Expression == nil {
+		return nil, diagnostics.New(MalformedStatement, stmt.Token.Span, "parse statement failed")
+	}
+	return &stmt, nil
+}
+
+// tryFunctionParameters parses `(a, b = 2, ...rest)`, reporting an error at
+// the first token that does not fit.
+func (parser *Parser) tryFunctionParameters() ([]ast.Parameter, bool) {
+	var ans []ast.Parameter
+	if !parser.tryToken(token.LPAREN) {
+		return nil, false
+	}
+	if parser.currentTokenIs(token.RPAREN) {
+		parser.eatToken()
+		return ans, true
+	}
+	for {
+		parameter, ok := parser.tryParameter(ans)
+		if !ok {
+			return nil, false
 		}
+		ans = append(ans, parameter)
+		if !parser.currentTokenIs(token.COMMA) {
+			break
+		}
+		parser.eatToken()
+	}
+	if !parser.tryToken(token.RPAREN) {
+		return nil, false
 	}
+	return ans, true
+}
+
+// tryParameter parses one parameter and checks that it may follow the ones
+// before it: names are unique, the rest parameter comes last and once a
+// parameter has a default all the following ones need one too.
+func (parser *Parser) tryParameter(before []ast.Parameter) (ast.Parameter, bool) {
+	var parameter ast.Parameter
+	if parser.currentTokenIs(token.ELLIPSIS) {
+		parameter.Rest = true
+		parser.eatToken()
+	}
+	name, err := parser.tryIdentExpr()
+	if err != nil {
+		return parameter, false
+	}
+	parameter.Name = name
 	parser.eatToken()
-	return ans
+	if parser.currentTokenIs(token.ASSIGN) {
+		parser.eatToken()
+		// binds tighter than `=`, which would be the next parameter's
+		parameter.Default = parser.tryExpression(ASSIGN)
+	}
+
+	// the parameter list reads fine, so parsing carries on after these
+	invalid := func(format string, a ...any) (ast.Parameter, bool) {
+		parser.addError(diagnostics.New(InvalidParameter, name.Token.Span, format, a...))
+		return parameter, true
+	}
+	for _, p := range before {
+		switch {
+		case p.Name.Value == name.Value:
+			return invalid("duplicate parameter %s", name.Value)
+		case p.Rest:
+			return invalid("parameter %s follows the rest parameter %s", name.Value, p.Name.Value)
+		}
+	}
+	switch {
+	case parameter.Rest && parameter.Default != nil:
+		return invalid("rest parameter %s can not have a default", name.Value)
+	case !parameter.Rest && parameter.Default == nil && len(before) > 0 && before[len(before)-1].Default != nil:
+		return invalid("parameter %s without a default follows one with a default", name.Value)
+	}
+	return parameter, true
+}
+
+// tryFunctionBody parses the body of a function with parse. break and
+// continue do not reach out of a function into a loop around it.
+func (parser *Parser) tryFunctionBody(parse func() (ast.BlockStatement, bool)) (ast.BlockStatement, bool) {
+	loops := parser.loops
+	parser.loops = 0
+	body, ok := parse()
+	parser.loops = loops
+	return body, ok
 }
@@ -34,8 +34,8 @@ func Test_parseFunctionLiteral(t *testing.T) {
 		t.Fatalf("function literal parameters wrong. want 2, got=%d\n",
 			len(function.Parameters))
 	}
-	testLiteralExpression(t, &function.Parameters[0], "x")
-	testLiteralExpression(t, &function.Parameters[1], "y")
+	testLiteralExpression(t, &function.Parameters[0].Name, "x")
+	testLiteralExpression(t, &function.Parameters[1].Name, "y")
 	if len(function.Body.Statements) != 1 {
 		t.Fatalf("function.Body.Statements has not 1 statements. got=%d\n",
 			len(function.Body.Statements))
@@ -47,3 +47,106 @@ func Test_parseFunctionLiteral(t *testing.T) {
 	}
 	testInfixExpression(t, bodyStmt.Expression, "x", "+", "y")
 }
+
+func Test_parseAnonymousFunctionLiteral(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun(a, b) { a + b }", "fun(a, b) {\n  (a + b)\n}"},
+		{"fun() { }", "fun() {\n}"},
+		{"let add = fun(a, b) { a + b }", "let add = fun(a, b) {\n  (a + b)\n};"},
+		{"map(xs, fun(x) { x * 2 })", "map(xs, fun(x) {\n  (x * 2)\n})"},
+		{"fun(x) { x }(5)", "(fun(x) {\n  x\n})(5)"},
+		{"(fun f(x) { x })(5)", "(fun f(x) {\n  x\n})(5)"},
+		{"fun() { fun() { 1 } }()()", "(fun() {\n  fun() {\n    1\n  }\n})()()"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_parseFunctionLiteralWithoutName(t *testing.T) {
+	l := lexer.New("fun(x) { x }")
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	function, ok := stmt.Expression.(ast.FunctionLiteral)
+	if !ok {
+		t.Fatalf("stmt.Expression is not ast.FunctionLiteral. got=%T", stmt.Expression)
+	}
+	if function.FunctionName.Literal != "" {
+		t.Errorf("function name wrong. want '', got=%q", function.FunctionName.Literal)
+	}
+	if len(function.Parameters) != 1 {
+		t.Fatalf("function literal parameters wrong. want 1, got=%d", len(function.Parameters))
+	}
+	if function.Span().String() != "1:1-1:13" {
+		t.Errorf("span wrong. expected=1:1-1:13, got=%v", function.Span())
+	}
+}
+
+func Test_parseFunctionDeclarationEndsWithItsBody(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"fun f(x) { x }\n(2 + 3)", []string{"fun f(x) {\n  x\n}", "(2 + 3)"}},
+		{"fun f(x) { x }\n[1, 2]", []string{"fun f(x) {\n  x\n}", "[1, 2]"}},
+		{"fun f() { } (1)", []string{"fun f() {\n}", "1"}},
+		{"fun() { 1 }(2)", []string{"(fun() {\n  1\n})(2)"}},
+		{"let f = fun f() { 1 }(2)", []string{"let f = (fun f() {\n  1\n})(2);"}},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if len(program.Statements) != len(tt.expected) {
+			t.Fatalf("%q: wrong number of statements. expected=%d, got=%d",
+				tt.input, len(tt.expected), len(program.Statements))
+		}
+		for i, expected := range tt.expected {
+			if program.Statements[i].String() != expected {
+				t.Errorf("%q: statements[%d] wrong. expected=%q, got=%q",
+					tt.input, i, expected, program.Statements[i].String())
+			}
+		}
+	}
+}
+
+func Test_calledFunctionStringRoundTrips(t *testing.T) {
+	tests := []string{
+		`fun(x) { x }(5)`,
+		`(fun f(x) { x })(5)`,
+		`fun() { fun() { 1 } }()()`,
+		`let v = fun f(n) { n }(1)`,
+		`(x => x)(1)`,
+		`(x => y => x + y)(1)(2)`,
+		`map(xs, (x => x)(f))`,
+	}
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		printed := program.String()
+
+		l = lexer.New(printed)
+		p = New(&l)
+		reparsed, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if reparsed.String() != printed {
+			t.Errorf("String() does not round-trip.\nfirst=%q\nsecond=%q",
+				printed, reparsed.String())
+		}
+	}
+}
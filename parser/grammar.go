@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/lexer"
+	"interpreter/token"
+)
+
+// Associativity decides how a chain of operators of the same precedence
+// groups.
+type Associativity int
+
+const (
+	// LeftAssociative groups `a - b - c` as `(a - b) - c`.
+	LeftAssociative Associativity = iota
+	// RightAssociative groups `a ** b ** c` as `a ** (b ** c)`.
+	RightAssociative
+)
+
+type infixRule struct {
+	parse         func(parser *Parser, left ast.IExpr) ast.IExpr
+	precedence    int
+	associativity Associativity
+}
+
+// Grammar is the Pratt table a Parser works from: which tokens start an
+// expression, which continue one, and how tightly the latter bind. Start
+// from DefaultGrammar and add operators to extend the language, e.g.
+//
+//	grammar := parser.DefaultGrammar()
+//	grammar.AddInfixOperator("|>", parser.LOWEST+5, parser.LeftAssociative)
+//	p := parser.NewWithGrammar(&l, grammar)
+//
+// Operators parse into ast.PrefixExpression and ast.InfixExpression; giving
+// them meaning is up to whoever evaluates the tree.
+type Grammar struct {
+	prefix map[token.Class]func(parser *Parser) ast.IExpr
+	infix  map[token.Class]infixRule
+	// operators are the spellings added to the language, the lexer has to
+	// learn them
+	operators map[string]token.Class
+}
+
+// DefaultGrammar returns a new copy of the language's own grammar.
+func DefaultGrammar() *Grammar {
+	grammar := &Grammar{
+		prefix:    make(map[token.Class]func(parser *Parser) ast.IExpr),
+		infix:     make(map[token.Class]infixRule),
+		operators: make(map[string]token.Class),
+	}
+
+	grammar.prefix[token.IDENT] = (*Parser).tryIdentifierExpr
+	grammar.prefix[token.INT] = (*Parser).tryIntegerLiteralExpr
+	grammar.prefix[token.FLOAT] = (*Parser).tryFloatLiteralExpr
+	grammar.prefix[token.STRING] = (*Parser).tryStringLiteralExpr
+	grammar.prefix[token.BANG] = (*Parser).tryPrefixExpr
+	grammar.prefix[token.MINUS] = (*Parser).tryPrefixExpr
+	grammar.prefix[token.FALSE] = (*Parser).tryBooleanLiteralExpr
+	grammar.prefix[token.TRUE] = (*Parser).tryBooleanLiteralExpr
+	grammar.prefix[token.LPAREN] = (*Parser).tryGroupedExpr
+	grammar.prefix[token.IF] = (*Parser).tryIfExpr
+	grammar.prefix[token.WHILE] = (*Parser).tryWhileExpr
+	grammar.prefix[token.FOR] = (*Parser).tryForExpr
+	grammar.prefix[token.LBRACE] = (*Parser).tryHashLiteral
+	grammar.prefix[token.FUNCTION] = (*Parser).tryFunctionLiteral
+	grammar.prefix[token.LBRACKET] = (*Parser).tryArrayLiteral
+
+	for _, class := range []token.Class{
+		token.ASSIGN, token.PLUS_ASSIGN, token.MINUS_ASSIGN, token.ASTERISK_ASSIGN,
+		token.SLASH_ASSIGN, token.PERCENT_ASSIGN, token.POWER_ASSIGN,
+	} {
+		grammar.addInfix(class, ASSIGN, (*Parser).tryAssignExpr)
+	}
+	grammar.addInfix(token.LOGICOR, LOGICOR, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.LOGICAND, LOGICAND, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.EQUAL, EQUALS, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.UNEQUAL, EQUALS, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.LT, LESSGREATER, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.GT, LESSGREATER, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.LTE, LESSGREATER, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.GTE, LESSGREATER, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.PLUS, SUM, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.MINUS, SUM, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.SLASH, PRODUCT, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.ASTERISK, PRODUCT, (*Parser).tryInfixExpr)
+	grammar.addInfix(token.PERCENT, PRODUCT, (*Parser).tryInfixExpr)
+	// `**` binds tighter than a prefix on its left, -2 ** 2 == -(2 ** 2)
+	grammar.infix[token.POWER] = infixRule{
+		parse:         (*Parser).tryInfixExpr,
+		precedence:    POWER,
+		associativity: RightAssociative,
+	}
+	grammar.addInfix(token.LPAREN, CALL, (*Parser).tryCallExpr)
+	grammar.addInfix(token.LBRACKET, INDEX, (*Parser).tryIndexExpr)
+	return grammar
+}
+
+func (grammar *Grammar) addInfix(class token.Class, precedence int, parse func(*Parser, ast.IExpr) ast.IExpr) {
+	grammar.infix[class] = infixRule{parse: parse, precedence: precedence}
+}
+
+// AddPrefixOperator makes operator a unary operator written in front of its
+// operand, binding like `-` and `!`. The operator's token class is its
+// spelling. A token that already starts an expression, such as `(` or `[`,
+// keeps being parsed the way it was.
+func (grammar *Grammar) AddPrefixOperator(operator string) error {
+	if err := grammar.addOperator(operator); err != nil {
+		return err
+	}
+	if _, ok := grammar.prefix[token.Class(operator)]; !ok {
+		grammar.prefix[token.Class(operator)] = (*Parser).tryPrefixExpr
+	}
+	return nil
+}
+
+// AddInfixOperator makes operator a binary operator. Precedences between the
+// predefined ones can be picked by adding to them, e.g. SUM+5 binds tighter
+// than `+` but looser than `*`. Adding an operator that exists already, such
+// as `+` or `=`, changes only its precedence and associativity; it keeps
+// being parsed the way it was.
+func (grammar *Grammar) AddInfixOperator(operator string, precedence int, associativity Associativity) error {
+	if err := grammar.addOperator(operator); err != nil {
+		return err
+	}
+	rule, ok := grammar.infix[token.Class(operator)]
+	if !ok {
+		rule.parse = (*Parser).tryInfixExpr
+	}
+	rule.precedence = precedence
+	rule.associativity = associativity
+	grammar.infix[token.Class(operator)] = rule
+	return nil
+}
+
+func (grammar *Grammar) addOperator(operator string) error {
+	if err := lexer.CheckOperator(operator); err != nil {
+		return err
+	}
+	grammar.operators[operator] = token.Class(operator)
+	return nil
+}
@@ -0,0 +1,169 @@
+package parser
+
+import (
+	"interpreter/lexer"
+	"interpreter/token"
+	"testing"
+)
+
+func Test_grammarAddInfixOperator(t *testing.T) {
+	grammar := DefaultGrammar()
+	operators := []struct {
+		operator      string
+		precedence    int
+		associativity Associativity
+	}{
+		{"|>", LOWEST + 5, LeftAssociative},
+		{"**", PRODUCT + 5, RightAssociative},
+		{"..", SUM - 5, LeftAssociative},
+	}
+	for _, op := range operators {
+		if err := grammar.AddInfixOperator(op.operator, op.precedence, op.associativity); err != nil {
+			t.Fatalf("AddInfixOperator(%q) failed: %v", op.operator, err)
+		}
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a |> f |> g", "((a |> f) |> g)"},
+		{"x == 1 |> f", "((x == 1) |> f)"},
+		{"2 ** 3 ** 2", "(2 ** (3 ** 2))"},
+		{"2 * 3 ** 2", "(2 * (3 ** 2))"},
+		{"-2 ** 2", "((-2) ** 2)"},
+		{"1..n + 1", "(1 .. (n + 1))"},
+		{"0 < 1..5", "(0 < (1 .. 5))"},
+		{"f(a ** b)[0]", "(f((a ** b))[0])"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := NewWithGrammar(&l, grammar)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+
+	// the default grammar does not know the new operators
+	l := lexer.New("a |> f")
+	p := New(&l)
+	_, _ = p.ParseProgram()
+	if len(p.Errors()) == 0 {
+		t.Fatalf("|> parsed with the default grammar")
+	}
+}
+
+func Test_grammarChangePredefinedOperator(t *testing.T) {
+	grammar := DefaultGrammar()
+	if err := grammar.AddInfixOperator("-", SUM, RightAssociative); err != nil {
+		t.Fatalf("AddInfixOperator failed: %v", err)
+	}
+	if err := grammar.AddInfixOperator("+", PRODUCT+5, LeftAssociative); err != nil {
+		t.Fatalf("AddInfixOperator failed: %v", err)
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a - b - c", "(a - (b - c))"},
+		{"a * b + c", "(a * (b + c))"},
+		{"-a + b", "((-a) + b)"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := NewWithGrammar(&l, grammar)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_grammarChangePredefinedOperatorKeepsItsParse(t *testing.T) {
+	grammar := DefaultGrammar()
+	for _, operator := range []string{"=", "+=", "(", "["} {
+		rule := grammar.infix[token.Class(operator)]
+		if err := grammar.AddInfixOperator(operator, rule.precedence, rule.associativity); err != nil {
+			t.Fatalf("AddInfixOperator(%q) failed: %v", operator, err)
+		}
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a = b = 1", "(a = (b = 1))"},
+		{"a += 1", "(a += 1)"},
+		{"f(1, 2)", "f(1, 2)"},
+		{"a[1]", "(a[1])"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := NewWithGrammar(&l, grammar)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_grammarAddPrefixOperator(t *testing.T) {
+	grammar := DefaultGrammar()
+	if err := grammar.AddPrefixOperator("~"); err != nil {
+		t.Fatalf("AddPrefixOperator failed: %v", err)
+	}
+
+	l := lexer.New("~a * ~~b")
+	p := NewWithGrammar(&l, grammar)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+	expected := "((~a) * (~(~b)))"
+	if program.String() != expected {
+		t.Errorf("expected=%q, got=%q", expected, program.String())
+	}
+}
+
+func Test_grammarAddPrefixOperatorKeepsItsParse(t *testing.T) {
+	grammar := DefaultGrammar()
+	for _, operator := range []string{"(", "[", "{", "-", "!"} {
+		if err := grammar.AddPrefixOperator(operator); err != nil {
+			t.Fatalf("AddPrefixOperator(%q) failed: %v", operator, err)
+		}
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"(1 + 2) * 3", "((1 + 2) * 3)"},
+		{"[1, 2][0]", "([1, 2][0])"},
+		{"let h = {1: 2}", "let h = {1: 2};"},
+		{"-a * !b", "((-a) * (!b))"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := NewWithGrammar(&l, grammar)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_grammarRejectsBadOperators(t *testing.T) {
+	grammar := DefaultGrammar()
+	for _, operator := range []string{"", "mod", "+1", "//", "_"} {
+		if err := grammar.AddInfixOperator(operator, SUM, LeftAssociative); err == nil {
+			t.Errorf("AddInfixOperator(%q) did not fail", operator)
+		}
+		if err := grammar.AddPrefixOperator(operator); err == nil {
+			t.Errorf("AddPrefixOperator(%q) did not fail", operator)
+		}
+	}
+}
@@ -1,12 +1,16 @@
 package parser
 
 import (
-	"fmt"
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/token"
 )
 
 func (parser *Parser) tryGroupedExpr() ast.IExpr {
+	if parser.startsArrowFunction() {
+		return parser.tryArrowFunction()
+	}
+	opening := parser.currentToken
 	parser.eatToken()
 	expr := parser.tryExpression(LOWEST)
 	if parser.currentTokenIs(token.RPAREN) {
@@ -14,8 +18,9 @@ func (parser *Parser) tryGroupedExpr() ast.IExpr {
 		return expr
 	}
 
-	parser.addError(fmt.Errorf(
+	parser.addError(diagnostics.New(
+		UnclosedGroup, parser.currentToken.Span,
 		"there is no right parenthesis after %s", expr,
-	))
+	).WithHint("the parenthesis opened at %v is never closed", opening.Span.Start))
 	return nil
 }
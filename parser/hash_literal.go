@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/token"
+)
+
+// tryHashLiteral parses `{key: value, ...}`. In expression position a `{`
+// always opens a hash literal; blocks only appear as statements and as the
+// bodies of if expressions and functions.
+func (parser *Parser) tryHashLiteral() ast.IExpr {
+	hash := ast.HashLiteral{OpeningBrace: parser.currentToken}
+	parser.eatToken()
+
+	for !parser.currentTokenIs(token.RBRACE) {
+		key := parser.tryExpression(LOWEST)
+		if !parser.tryToken(token.COLON) {
+			return nil
+		}
+		value := parser.tryExpression(LOWEST)
+		hash.Pairs = append(hash.Pairs, ast.HashPair{Key: key, Value: value})
+
+		if !parser.currentTokenIs(token.RBRACE) && !parser.tryToken(token.COMMA) {
+			return nil
+		}
+	}
+
+	hash.ClosingBrace = parser.currentToken
+	parser.eatToken()
+	return hash
+}
@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"fmt"
+	"interpreter/ast"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseHashLiteral(t *testing.T) {
+	input := `let h = {"one": 1, "two": 0 + 2, 3: true, false: "f"};`
+
+	l := lexer.New(input)
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt, ok := program.Statements[0].(*ast.LetStatement)
+	if !ok {
+		t.Fatalf("program.Statements[0] is not *ast.LetStatement. got=%T",
+			program.Statements[0])
+	}
+	hash, ok := stmt.Value.(ast.HashLiteral)
+	if !ok {
+		t.Fatalf("exp is not ast.HashLiteral. got=%T", stmt.Value)
+	}
+	if len(hash.Pairs) != 4 {
+		t.Fatalf("hash.Pairs has wrong length. got=%d", len(hash.Pairs))
+	}
+	expected := `{"one": 1, "two": (0 + 2), 3: true, false: "f"}`
+	if hash.String() != expected {
+		t.Errorf("hash.String() wrong. expected=%q, got=%q", expected, hash.String())
+	}
+	testInfixExpression(t, hash.Pairs[1].Value, 0, "+", 2)
+}
+
+func Test_parseEmptyHashLiteral(t *testing.T) {
+	l := lexer.New("let h = {};")
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	hash, ok := program.Statements[0].(*ast.LetStatement).Value.(ast.HashLiteral)
+	if !ok {
+		t.Fatalf("exp is not ast.HashLiteral. got=%T",
+			program.Statements[0].(*ast.LetStatement).Value)
+	}
+	if len(hash.Pairs) != 0 {
+		t.Errorf("hash.Pairs has wrong length. got=%d", len(hash.Pairs))
+	}
+}
+
+func Test_parseBraceDisambiguation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// statement position: a block unless the first entry is followed by `:`
+		{`{ x }`, "ast.BlockStatement"},
+		{`{ }`, "ast.BlockStatement"},
+		{`{ let a = 1; a }`, "ast.BlockStatement"},
+		{`{"a": 1}["a"]`, "*ast.ExpressionStatement"},
+		{`{x: 1}`, "*ast.ExpressionStatement"},
+		// expression position: always a hash
+		{`f({})`, "*ast.ExpressionStatement"},
+		{`[{1: 2}]`, "*ast.ExpressionStatement"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if len(program.Statements) != 1 {
+			t.Fatalf("%q: program.Statements does not contain 1 statement. got=%d",
+				tt.input, len(program.Statements))
+		}
+		if actual := fmt.Sprintf("%T", program.Statements[0]); actual != tt.expected {
+			t.Errorf("%q: statement type wrong. expected=%s, got=%s",
+				tt.input, tt.expected, actual)
+		}
+	}
+}
+
+func Test_parseHashLiteralErrors(t *testing.T) {
+	tests := []string{
+		`let h = {"a" 1};`,
+		`let h = {"a": 1 "b": 2};`,
+		`let h = {"a": 1`,
+	}
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		if len(p.Errors()) == 0 {
+			t.Errorf("%q: no error reported", input)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 )
 
 func (parser *Parser) tryIdentifierExpr() ast.IExpr {
+	if parser.nextTokenIs(token.ARROW) {
+		return parser.tryArrowFunction()
+	}
 	identifier := parser.currentToken
 	parser.eatToken()
 	return &ast.Identifier{
@@ -10,13 +10,59 @@ func (parser *Parser) tryIfExpr() ast.IExpr {
 
 	parser.eatToken()
 
-	if parser.currentTokenIs(token.LPAREN) {
-		parser.eatToken()
-		expr.Predicate = parser.tryExpression(LOWEST)
+	if !parser.tryToken(token.LPAREN) {
+		return nil
+	}
+	expr.Predicate = parser.tryExpression(LOWEST)
+	if !parser.tryToken(token.RPAREN) {
+		return nil
+	}
+
+	then, ok := parser.tryBlock()
+	if !ok {
+		return nil
+	}
+	expr.Then = &then
+
+	if !parser.currentTokenIs(token.ELSE) {
+		return expr
 	}
 	parser.eatToken()
 
-	var b = (parser.tryBlockStatement()).(ast.BlockStatement)
-	expr.Then = &b
+	// `else if` is sugar for an else block holding just the nested if
+	if parser.currentTokenIs(token.IF) {
+		elseIf := ast.ExpressionStatement{Token: parser.currentToken}
+		nested, ok := parser.tryIfExpr().(ast.IfExpression)
+		if !ok {
+			return nil
+		}
+		elseIf.Expression = nested
+		last := nested.Then
+		if nested.Else != nil {
+			last = nested.Else
+		}
+		expr.Else = &ast.BlockStatement{
+			OpeningBracket: elseIf.Token,
+			ClosingBracket: last.ClosingBracket,
+			Statements:     []ast.Statement{&elseIf},
+		}
+		return expr
+	}
+
+	otherwise, ok := parser.tryBlock()
+	if !ok {
+		return nil
+	}
+	expr.Else = &otherwise
 	return expr
 }
+
+// tryBlock parses a block statement, reporting an error if the current
+// token does not open one.
+func (parser *Parser) tryBlock() (ast.BlockStatement, bool) {
+	if err := parser.errorCurrentTokenMismatch(token.LBRACE); err != nil {
+		parser.addError(err)
+		return ast.BlockStatement{}, false
+	}
+	return (parser.tryBlockStatement()).(ast.BlockStatement), true
+}
@@ -45,3 +45,127 @@ func Test_parseIfExpression(t *testing.T) {
 		t.Errorf("exp.Alternative.Statements was not nil. got=%+v", exp.Else)
 	}
 }
+
+func Test_parseIfElseExpression(t *testing.T) {
+	input := `if (x < y) { x } else { y }`
+
+	l := lexer.New(input)
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain %d statements. got=%d\n",
+			1, len(program.Statements))
+	}
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got=%T",
+			program.Statements[0])
+	}
+	exp, ok := stmt.Expression.(ast.IfExpression)
+	if !ok {
+		t.Fatalf("stmt.Expression is not ast.IfExpression. got=%T",
+			stmt.Expression)
+	}
+	if !testInfixExpression(t, exp.Predicate, "x", "<", "y") {
+		return
+	}
+	if len(exp.Then.Statements) != 1 {
+		t.Errorf("consequence is not 1 statements. got=%d\n",
+			len(exp.Then.Statements))
+	}
+	consequence, ok := exp.Then.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("Statements[0] is not ast.ExpressionStatement. got=%T",
+			exp.Then.Statements[0])
+	}
+	if !testIdentifier(t, consequence.Expression, "x") {
+		return
+	}
+	if exp.Else == nil || len(exp.Else.Statements) != 1 {
+		t.Fatalf("alternative is not 1 statements. got=%+v\n", exp.Else)
+	}
+	alternative, ok := exp.Else.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("Statements[0] is not ast.ExpressionStatement. got=%T",
+			exp.Else.Statements[0])
+	}
+	testIdentifier(t, alternative.Expression, "y")
+}
+
+func Test_parseElseIfChain(t *testing.T) {
+	input := `if (a) { 1 } else if (b) { 2 } else if (c) { 3 } else { 4 }`
+
+	l := lexer.New(input)
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain %d statements. got=%d\n",
+			1, len(program.Statements))
+	}
+	exp := program.Statements[0].(*ast.ExpressionStatement).Expression
+	for _, predicate := range []string{"a", "b", "c"} {
+		ifExp, ok := exp.(ast.IfExpression)
+		if !ok {
+			t.Fatalf("expression is not ast.IfExpression. got=%T", exp)
+		}
+		if !testIdentifier(t, ifExp.Predicate, predicate) {
+			return
+		}
+		if ifExp.Else == nil || len(ifExp.Else.Statements) != 1 {
+			t.Fatalf("else branch of %s is not 1 statement. got=%+v", predicate, ifExp.Else)
+		}
+		exp = ifExp.Else.Statements[0].(*ast.ExpressionStatement).Expression
+	}
+	testIntegerLiteral(t, exp, 4)
+
+	span := program.Statements[0].Span()
+	if span.Start.Offset != 0 || span.End.Offset != len(input) {
+		t.Errorf("span does not cover the whole chain. got=%v", span)
+	}
+}
+
+func Test_ifExpressionStringRoundTrips(t *testing.T) {
+	tests := []string{
+		`if (x < y) { x }`,
+		`if (x < y) { x } else { y }`,
+		`if (a) { 1 } else if (b) { 2 } else { if (c) { 3 } }`,
+		`if (a) { let x = if (b) { 1 } else { 2 }; x } else { 0 }`,
+	}
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		printed := program.String()
+
+		l = lexer.New(printed)
+		p = New(&l)
+		reparsed, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if reparsed.String() != printed {
+			t.Errorf("String() does not round-trip.\nfirst=%q\nsecond=%q",
+				printed, reparsed.String())
+		}
+	}
+}
+
+func Test_parseIfExpressionErrors(t *testing.T) {
+	tests := []string{
+		`if x { 1 }`,
+		`if (x { 1 }`,
+		`if (x) 1`,
+		`if (x) { 1 } else 2`,
+	}
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		if len(p.Errors()) == 0 {
+			t.Errorf("%q: no error reported", input)
+		}
+	}
+}
@@ -1,23 +1,32 @@
 package parser
 
 import (
-	"fmt"
+	"errors"
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/token"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
 func (parser *Parser) tryIntegerLiteralExpr() ast.IExpr {
 	t := parser.currentToken
 	parser.eatToken()
 
-	number, err := strconv.Atoi(t.Literal)
+	number, err := parseInteger(t)
+	if errors.Is(err, strconv.ErrRange) {
+		// base 0 takes the same prefixes and underscores as strconv does
+		value, _ := new(big.Int).SetString(t.Literal, 0)
+		return &ast.IntegerLiteral{
+			Token: t,
+			Big:   value,
+		}
+	}
 	if err != nil {
-		parser.addError(fmt.Errorf(
-			"%s can not be parsed as base 10 integer", t.Literal,
-		))
+		parser.addError(err)
 		return &ast.IntegerLiteral{
-			Token: token.New(token.ILLEGAL, t.Literal),
+			Token: token.Token{Class: token.ILLEGAL, Literal: t.Literal, Span: t.Span},
 			Value: 0,
 		}
 	}
@@ -26,3 +35,31 @@ func (parser *Parser) tryIntegerLiteralExpr() ast.IExpr {
 		Value: number,
 	}
 }
+
+// parseInteger reads decimal, 0x hexadecimal, 0o octal and 0b binary
+// literals, with `_` allowed between digits. A decimal literal may not start
+// with 0 so that 017 is not silently read as octal. Literals too large for an
+// int give strconv.ErrRange.
+func parseInteger(t token.Token) (int, error) {
+	literal := t.Literal
+	lower := strings.ToLower(literal)
+	if len(literal) > 1 && literal[0] == '0' &&
+		!strings.HasPrefix(lower, "0x") && !strings.HasPrefix(lower, "0o") && !strings.HasPrefix(lower, "0b") {
+		return 0, diagnostics.New(
+			MalformedInteger, t.Span,
+			"leading zeros in decimal integer %s", literal,
+		).WithHint("write 0o%s for an octal number", strings.TrimLeft(literal, "0_"))
+	}
+
+	number, err := strconv.ParseInt(literal, 0, strconv.IntSize)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, strconv.ErrRange
+	}
+	if err != nil {
+		return 0, diagnostics.New(
+			MalformedInteger, t.Span,
+			"%s can not be parsed as an integer", literal,
+		)
+	}
+	return int(number), nil
+}
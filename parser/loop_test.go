@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseLoops(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"while (x < 10) { x += 1 }", "while ((x < 10)) {\n  (x += 1)\n}"},
+		{"while (true) { break; continue }", "while (true) {\n  break;\n  continue;\n}"},
+		{
+			"for (let i = 0; i < 3; i = i + 1) { i }",
+			"for (let i = 0; (i < 3); (i = (i + 1))) {\n  i\n}",
+		},
+		{"for (i = 0; i < 3; i += 1) { }", "for ((i = 0); (i < 3); (i += 1)) {\n}"},
+		{"for (;;) { break }", "for (;;) {\n  break;\n}"},
+		{"for (; x;) { }", "for (; x;) {\n}"},
+		{"for x in xs { x }", "for x in xs {\n  x\n}"},
+		{"for (x in [1, 2]) { x }", "for x in [1, 2] {\n  x\n}"},
+		{"for k in {1: 2} { k }", "for k in {1: 2} {\n  k\n}"},
+		{
+			"while (a) { for x in xs { if (x) { break } } }",
+			"while (a) {\n  for x in xs {\n    if (x) {\n      break;\n    }\n  }\n}",
+		},
+		{"let n = while (false) { }; n", "let n = while (false) {\n};n"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_parseForInExpression(t *testing.T) {
+	l := lexer.New("for item in items { item }")
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	loop, ok := stmt.Expression.(ast.ForInExpression)
+	if !ok {
+		t.Fatalf("exp not ast.ForInExpression. got=%T", stmt.Expression)
+	}
+	testIdentifier(t, loop.Variable, "item")
+	testIdentifier(t, loop.Iterable, "items")
+	if len(loop.Body.Statements) != 1 {
+		t.Fatalf("body is not 1 statement. got=%d", len(loop.Body.Statements))
+	}
+	if loop.Span().String() != "1:1-1:27" {
+		t.Errorf("span wrong. expected=1:1-1:27, got=%v", loop.Span())
+	}
+}
+
+func Test_parseMisplacedLoopControl(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"break", "1:1: break outside of a loop"},
+		{"if (true) { continue }", "1:13: continue outside of a loop"},
+		// a function body is not part of the loop around the function
+		{"while (true) { fun f() { break } }", "1:26: break outside of a loop"},
+		{"for x in xs { } break", "1:17: break outside of a loop"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got=%d (%v)", tt.input, len(errors), errors)
+		}
+		d, ok := errors[0].(diagnostics.Diagnostic)
+		if !ok || d.Code != MisplacedLoopControl {
+			t.Fatalf("%q: wrong error. got=%#v", tt.input, errors[0])
+		}
+		if d.Error() != tt.expectedMessage {
+			t.Errorf("%q: message wrong. expected=%q, got=%q", tt.input, tt.expectedMessage, d.Error())
+		}
+	}
+}
+
+func Test_parseMalformedLoops(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"while x { }", `1:7: expected class (, got IDENT "x"`},
+		{"for (let i = 0; i < 3) { }", `1:22: expected class ;, got ) ")"`},
+		{"for x of xs { }", `1:7: expected class IN, got IDENT "of"`},
+		{"for (x in xs { }", `1:14: expected class ), got { "{"`},
+		{"while (true) x", `1:14: expected class {, got IDENT "x"`},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%q: no error reported", tt.input)
+		}
+		if errors[0].Error() != tt.expectedMessage {
+			t.Errorf("%q: message wrong. expected=%q, got=%q", tt.input, tt.expectedMessage, errors[0].Error())
+		}
+	}
+}
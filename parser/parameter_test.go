@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseParametersAndArguments(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fun f(a, b = 2) { }", "fun f(a, b = 2) {\n}"},
+		{"fun f(a = 1 + 2, b = a) { }", "fun f(a = (1 + 2), b = a) {\n}"},
+		{"fun f(...xs) { }", "fun f(...xs) {\n}"},
+		{"fun f(a, b = [], ...rest) { }", "fun f(a, b = [], ...rest) {\n}"},
+		{"(a, b = 1) => a + b", "(a, b = 1) => (a + b)"},
+		{"(...xs) => xs", "(...xs) => xs"},
+		{"f(...xs)", "f(...xs)"},
+		{"f(1, ...xs, ...[2, 3])", "f(1, ...xs, ...[2, 3])"},
+		{"[0, ...xs]", "[0, ...xs]"},
+		{"f(a, b: 2, c: x + 1)", "f(a, b: 2, c: (x + 1))"},
+		{"f({a: 1})", "f({a: 1})"},
+		// an assignment is still an assignment
+		{"f(a = 1)", "f((a = 1))"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		if program.String() != tt.expected {
+			t.Errorf("%q: expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+	}
+}
+
+func Test_parseParameterNodes(t *testing.T) {
+	l := lexer.New("fun f(a, b = 2, ...rest) { }")
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	function := program.Statements[0].(*ast.ExpressionStatement).Expression.(ast.FunctionLiteral)
+	if len(function.Parameters) != 3 {
+		t.Fatalf("function literal parameters wrong. want 3, got=%d", len(function.Parameters))
+	}
+	a, b, rest := function.Parameters[0], function.Parameters[1], function.Parameters[2]
+	testIdentifier(t, &a.Name, "a")
+	if a.Default != nil || a.Rest {
+		t.Errorf("a is not a plain parameter. got=%+v", a)
+	}
+	testIdentifier(t, &b.Name, "b")
+	testIntegerLiteral(t, b.Default, 2)
+	testIdentifier(t, &rest.Name, "rest")
+	if !rest.Rest || rest.Default != nil {
+		t.Errorf("rest is not a rest parameter. got=%+v", rest)
+	}
+}
+
+func Test_parseInvalidParametersAndArguments(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedCode    string
+		expectedMessage string
+	}{
+		{"fun f(a, a) { }", InvalidParameter, "1:10: duplicate parameter a"},
+		{"fun f(...xs, y) { }", InvalidParameter, "1:14: parameter y follows the rest parameter xs"},
+		{"fun f(...xs = []) { }", InvalidParameter, "1:10: rest parameter xs can not have a default"},
+		{"fun f(a = 1, b) { }", InvalidParameter, "1:14: parameter b without a default follows one with a default"},
+		{"(a, ...b, c) => a", InvalidParameter, "1:11: parameter c follows the rest parameter b"},
+		{"f(a: 1, a: 2)", InvalidArgument, "1:9: duplicate argument a"},
+		{"f(a: 1, 2)", InvalidArgument, "1:9: positional argument 2 follows a named argument"},
+		{"f(a: 1, ...xs)", InvalidArgument, "1:9: positional argument ...xs follows a named argument"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got=%d (%v)", tt.input, len(errors), errors)
+		}
+		d, ok := errors[0].(diagnostics.Diagnostic)
+		if !ok || d.Code != tt.expectedCode {
+			t.Fatalf("%q: wrong error. got=%#v", tt.input, errors[0])
+		}
+		if d.Error() != tt.expectedMessage {
+			t.Errorf("%q: message wrong. expected=%q, got=%q", tt.input, tt.expectedMessage, d.Error())
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package parser
 
 import (
-	"fmt"
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/lexer"
 	"interpreter/token"
 )
@@ -13,14 +13,24 @@ type (
 )
 
 type Parser struct {
-	lexer                *lexer.Lexer
-	currentToken         token.Token
-	nextToken            token.Token
-	statements           []ast.Statement
-	errors               []error
+	lexer        *lexer.Lexer
+	currentToken token.Token
+	nextToken    token.Token
+	statements   []ast.Statement
+	errors       []error
+	// panicking is set by the first error in a statement and cleared once
+	// the parser has skipped to where the next statement can start. Errors
+	// reported in between are most likely knock-on effects and are dropped.
+	panicking bool
+	// loops counts the loops around the statement being parsed, within the
+	// innermost function
+	loops int
+	// braces counts the `{` eaten so far less the `}`
+	braces               int
 	prefixParseFunctions map[token.Class]prefixParseFunction
 	infixParseFunctions  map[token.Class]infixParseFunction
 	dictPrecedence       map[token.Class]int
+	dictAssociativity    map[token.Class]Associativity
 }
 
 func (parser *Parser) addPrefixFn(class token.Class, function prefixParseFunction) {
@@ -36,75 +46,129 @@ func (parser *Parser) Errors() []error {
 }
 
 func (parser *Parser) addError(err error) {
-	if err != nil {
+	if err == nil {
+		return
+	}
+	if !parser.panicking {
 		parser.errors = append(parser.errors, err)
 	}
+	parser.panicking = true
 }
 
+// New creates a parser for the language's own grammar.
 func New(lexer *lexer.Lexer) *Parser {
-	var _ error
+	return NewWithGrammar(lexer, DefaultGrammar())
+}
+
+// NewWithGrammar creates a parser for grammar, teaching lexer the operators
+// that grammar adds. The lexer must not have read any tokens yet.
+func NewWithGrammar(lexer *lexer.Lexer, grammar *Grammar) *Parser {
 	parser := Parser{
-		lexer: lexer,
-	}
-	parser.dictPrecedence = map[token.Class]int{
-		token.EQUAL:    EQUALS,
-		token.UNEQUAL:  EQUALS,
-		token.LT:       LESSGREATER,
-		token.GT:       LESSGREATER,
-		token.PLUS:     SUM,
-		token.MINUS:    SUM,
-		token.SLASH:    PRODUCT,
-		token.ASTERISK: PRODUCT,
-		token.LPAREN:   CALL,
-	}
-	parser.currentToken, _ = parser.lexer.NextToken()
-	parser.nextToken, _ = parser.lexer.NextToken()
-
-	parser.prefixParseFunctions = make(map[token.Class]prefixParseFunction)
-	parser.addPrefixFn(token.IDENT, parser.tryIdentifierExpr)
-	parser.addPrefixFn(token.INT, parser.tryIntegerLiteralExpr)
-	parser.addPrefixFn(token.BANG, parser.tryPrefixExpr)
-	parser.addPrefixFn(token.MINUS, parser.tryPrefixExpr)
-	parser.addPrefixFn(token.FALSE, parser.tryBooleanLiteralExpr)
-	parser.addPrefixFn(token.TRUE, parser.tryBooleanLiteralExpr)
-	parser.addPrefixFn(token.LPAREN, parser.tryGroupedExpr)
-	parser.addPrefixFn(token.IF, parser.tryIfExpr)
-	parser.addPrefixFn(token.LBRACE, parser.tryBlockStatement)
-	parser.addPrefixFn(token.FUNCTION, parser.tryFunctionLiteral)
-
-	parser.infixParseFunctions = make(map[token.Class]infixParseFunction)
-	parser.addInfixFn(token.PLUS, parser.tryInfixExpr)
-	parser.addInfixFn(token.PLUS, parser.tryInfixExpr)
-	parser.addInfixFn(token.MINUS, parser.tryInfixExpr)
-	parser.addInfixFn(token.SLASH, parser.tryInfixExpr)
-	parser.addInfixFn(token.ASTERISK, parser.tryInfixExpr)
-	parser.addInfixFn(token.EQUAL, parser.tryInfixExpr)
-	parser.addInfixFn(token.UNEQUAL, parser.tryInfixExpr)
-	parser.addInfixFn(token.LT, parser.tryInfixExpr)
-	parser.addInfixFn(token.GT, parser.tryInfixExpr)
-	parser.addInfixFn(token.LPAREN, parser.tryCallExpr)
+		lexer:                lexer,
+		prefixParseFunctions: make(map[token.Class]prefixParseFunction),
+		infixParseFunctions:  make(map[token.Class]infixParseFunction),
+		dictPrecedence:       make(map[token.Class]int),
+		dictAssociativity:    make(map[token.Class]Associativity),
+	}
+	for operator, class := range grammar.operators {
+		// Grammar has checked the operator already
+		_ = lexer.AddOperator(operator, class)
+	}
+	for class, parse := range grammar.prefix {
+		parse := parse
+		parser.addPrefixFn(class, func() ast.IExpr { return parse(&parser) })
+	}
+	for class, rule := range grammar.infix {
+		rule := rule
+		parser.addInfixFn(class, func(left ast.IExpr) ast.IExpr { return rule.parse(&parser, left) })
+		parser.dictPrecedence[class] = rule.precedence
+		parser.dictAssociativity[class] = rule.associativity
+	}
+
+	parser.eatToken()
+	parser.eatToken()
 	return &parser
 }
 
+// ParseProgram parses the whole input. Statements that fail to parse are
+// left out of the program and the parser carries on with the next one, so
+// that every independent error ends up in Errors. The returned error is the
+// first of them.
 func (parser *Parser) ParseProgram() (*ast.Program, error) {
 	for !parser.currentTokenIs(token.EOF) {
-		stmt, err := parser.tryStatement()
+		if stmt, ok := parser.tryStatementOrRecover(); ok {
+			parser.statements = append(parser.statements, stmt)
+		}
+	}
+	program := &ast.Program{Statements: parser.statements}
+	if len(parser.errors) > 0 {
+		return program, parser.errors[0]
+	}
+	return program, nil
+}
+
+// tryStatementOrRecover parses one statement and eats the `;` after it. If
+// the statement fails, the parser skips to the start of the next one and ok
+// is false.
+func (parser *Parser) tryStatementOrRecover() (stmt ast.Statement, ok bool) {
+	start, depth := parser.currentToken, parser.braces
+	stmt, err := parser.tryStatement()
+	parser.addError(err)
+	if parser.panicking {
+		parser.synchronize(start, depth)
+		return nil, false
+	}
+	if parser.currentTokenIs(token.SEMICOLON) {
+		parser.eatToken()
+	}
+	return stmt, stmt != nil
+}
+
+// statementKeywords start statements, so the parser can resume at them after
+// an error.
+var statementKeywords = map[token.Class]bool{
+	token.LET:      true,
+	token.RETURN:   true,
+	token.WHILE:    true,
+	token.FOR:      true,
+	token.BREAK:    true,
+	token.CONTINUE: true,
+}
+
+// synchronize skips the rest of a statement that failed to parse: up to and
+// including the next `;`, or up to a `}` or a keyword that starts a new
+// statement. Braces the statement opened, at depth and deeper, are skipped
+// up to and including their `}`. It always moves past start, so parsing can
+// not get stuck.
+func (parser *Parser) synchronize(start token.Token, depth int) {
+	if parser.currentToken == start {
+		parser.eatToken()
+	}
+	for !parser.currentTokenIs(token.EOF) {
+		if parser.braces > depth {
+			parser.eatToken()
+			continue
+		}
 		if parser.currentTokenIs(token.SEMICOLON) {
 			parser.eatToken()
+			break
 		}
-		parser.statements = append(parser.statements, stmt)
-		if err != nil {
-			return &ast.Program{Statements: parser.statements},
-				fmt.Errorf("%v", err)
+		if parser.currentTokenIs(token.RBRACE) || statementKeywords[parser.currentToken.Class] {
+			break
 		}
+		parser.eatToken()
 	}
-	return &ast.Program{Statements: parser.statements}, nil
+	parser.panicking = false
 }
 
 func (parser *Parser) tryStatement() (ast.Statement, error) {
 	switch parser.currentToken.Class {
 	case token.LBRACE:
 		{
+			// `{ key: ...` at the start of a statement is a hash literal
+			if parser.peekTokenAfterNext().Class == token.COLON {
+				return parser.tryExpressionStatementOrFail()
+			}
 			stmt := (parser.tryBlockStatement()).(ast.Statement)
 			return stmt, nil
 		}
@@ -118,20 +182,55 @@ func (parser *Parser) tryStatement() (ast.Statement, error) {
 			stmt, err := parser.tryReturnStatement()
 			return &stmt, err
 		}
-	default:
+	case token.FUNCTION:
 		{
-			stmt, ok := parser.tryExpressionStatement()
-			if !ok {
-				return nil, fmt.Errorf("parse statement failed")
+			if parser.nextTokenIs(token.IDENT) {
+				return parser.tryFunctionDeclaration()
 			}
-			return &stmt, nil
+			return parser.tryExpressionStatementOrFail()
 		}
+	case token.BREAK, token.CONTINUE:
+		{
+			return parser.tryLoopControlStatement()
+		}
+	default:
+		{
+			return parser.tryExpressionStatementOrFail()
+		}
+	}
+}
+
+func (parser *Parser) tryExpressionStatementOrFail() (ast.Statement, error) {
+	span := parser.currentToken.Span
+	stmt, ok := parser.tryExpressionStatement()
+	if !ok {
+		return nil, diagnostics.New(MalformedStatement, span, "parse statement failed")
 	}
+	return &stmt, nil
 }
 
+// diagnostic codes reported by the parser
+const (
+	UnexpectedToken         = "E001"
+	NoPrefixParseFunction   = "E002"
+	UnclosedGroup           = "E003"
+	MalformedInteger        = "E004"
+	MalformedBoolean        = "E005"
+	MalformedStatement      = "E006"
+	MalformedFloat          = "E007"
+	NumberOverflow          = "E008"
+	InvalidAssignmentTarget = "E009"
+	MisplacedLoopControl    = "E010"
+	InvalidParameter        = "E011"
+	InvalidArgument         = "E012"
+)
+
 func errorTokenMismatch(actual token.Token, expected token.Class) error {
 	if actual.Class != expected {
-		return fmt.Errorf("expected class %v, got %v", expected, actual)
+		return diagnostics.New(
+			UnexpectedToken, actual.Span,
+			"expected class %v, got %v %q", expected, actual.Class, actual.Literal,
+		)
 	}
 	return nil
 }
@@ -148,24 +247,60 @@ func (parser *Parser) nextTokenIs(expected token.Class) bool {
 	return nil == errorTokenMismatch(parser.nextToken, expected)
 }
 
+// tryToken eats the current token if it is of the expected class and
+// reports an error otherwise.
+func (parser *Parser) tryToken(expected token.Class) bool {
+	if err := parser.errorCurrentTokenMismatch(expected); err != nil {
+		parser.addError(err)
+		return false
+	}
+	parser.eatToken()
+	return true
+}
+
 func (parser *Parser) tryAssignOp() error {
 	return parser.errorCurrentTokenMismatch(token.ASSIGN)
 }
 
+// peekTokenAfterNext looks one token past nextToken without consuming it.
+func (parser *Parser) peekTokenAfterNext() token.Token {
+	t, _ := parser.lexer.Peek(1)
+	return t
+}
+
 func (parser *Parser) eatToken() {
+	var err error
+	switch parser.currentToken.Class {
+	case token.LBRACE:
+		parser.braces++
+	case token.RBRACE:
+		parser.braces--
+	}
 	parser.currentToken = parser.nextToken
-	parser.nextToken, _ = parser.lexer.NextToken()
+	parser.nextToken, err = parser.lexer.NextToken()
+	// lexical errors are independent of the statement being parsed, so
+	// they neither start nor wait out a panic
+	if err != nil {
+		parser.errors = append(parser.errors, err)
+	}
 }
 
+// Precedences of the predefined operators, from loosest to tightest. They
+// are ten apart to leave room for operators added through a Grammar.
 const (
-	_ int = iota
+	_ int = iota * 10
 	LOWEST
+	ASSIGN
+	LOGICOR
+	LOGICAND
 	EQUALS
 	LESSGREATER
 	SUM
 	PRODUCT
 	PREFIX
+	POWER
 	CALL
+	INDEX
 )
 
 func (parser *Parser) tryExpressionStatement() (ast.ExpressionStatement, bool) {
@@ -179,15 +314,29 @@ func (parser *Parser) tryExpressionStatement() (ast.ExpressionStatement, bool) {
 
 func (parser *Parser) tryExpression(precedence int) ast.IExpr {
 	prefix, ok := parser.prefixParseFunctions[parser.currentToken.Class]
+	if !ok && parser.currentTokenIs(token.ILLEGAL) {
+		// the lexer has reported this one already
+		parser.panicking = true
+		return nil
+	}
 	if !ok {
-		parser.addError(fmt.Errorf(
-			"no prefix parse function for %T%+v",
-			parser.currentToken, parser.currentToken,
-		))
+		d := diagnostics.New(
+			NoPrefixParseFunction, parser.currentToken.Span,
+			"no prefix parse function for %v %q",
+			parser.currentToken.Class, parser.currentToken.Literal,
+		)
+		if parser.currentTokenIs(token.EOF) {
+			d = d.WithHint("the input ended where an expression was expected")
+		} else {
+			d = d.WithHint("%q can not start an expression", parser.currentToken.Literal)
+		}
+		parser.addError(d)
 		return nil
 	}
 	leftExpr := prefix()
-	for !parser.nextTokenIs(token.SEMICOLON) && precedence < parser.currentTokenPrecedence() {
+	// after a failed parse there is nothing sound to build on
+	for leftExpr != nil && !parser.panicking &&
+		!parser.currentTokenIs(token.SEMICOLON) && precedence < parser.currentTokenPrecedence() {
 		infix := parser.infixParseFunctions[parser.currentToken.Class]
 		if infix == nil {
 			return leftExpr
@@ -216,6 +365,11 @@ func (parser *Parser) tryInfixExpr(left ast.IExpr) ast.IExpr {
 	}
 
 	precedence := parser.currentTokenPrecedence()
+	if parser.dictAssociativity[parser.currentToken.Class] == RightAssociative {
+		// let an operator of the same precedence on the right take the
+		// operand first
+		precedence -= 1
+	}
 	parser.eatToken()
 
 	expr.Right = parser.tryExpression(precedence)
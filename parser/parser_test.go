@@ -3,9 +3,11 @@ package parser
 import (
 	"fmt"
 	"interpreter/ast"
+	"interpreter/diagnostics"
 	"interpreter/lexer"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func Test_LetStatements(t *testing.T) {
@@ -247,6 +249,8 @@ func Test_parseInfixExpressions(t *testing.T) {
 		{"5 < 6;", 5, "<", 6},
 		{"5 == 6;", 5, "==", 6},
 		{"5 != 6;", 5, "!=", 6},
+		{"5 && 6;", 5, "&&", 6},
+		{"5 || 6;", 5, "||", 6},
 	}
 	for _, tt := range infixTests {
 		l := lexer.New(tt.input)
@@ -376,6 +380,46 @@ func Test_parseOperatorsWithPrecedence(t *testing.T) {
 			"3 + 4 * 5 == 3 * 1 + 4 * 5",
 			"((3 + (4 * 5)) == ((3 * 1) + (4 * 5)))",
 		},
+		{
+			"a || b && c",
+			"(a || (b && c))",
+		},
+		{
+			"a && b || c && d",
+			"((a && b) || (c && d))",
+		},
+		{
+			"a == b && c != d",
+			"((a == b) && (c != d))",
+		},
+		{
+			"a || b || c",
+			"((a || b) || c)",
+		},
+		{
+			"!a && b < c",
+			"((!a) && (b < c))",
+		},
+		{
+			"a <= b == c >= d",
+			"((a <= b) == (c >= d))",
+		},
+		{
+			"a + b <= c * d",
+			"((a + b) <= (c * d))",
+		},
+		{
+			"a % b * c",
+			"((a % b) * c)",
+		},
+		{
+			"a + b % c",
+			"(a + (b % c))",
+		},
+		{
+			"-a % b",
+			"((-a) % b)",
+		},
 	}
 	for _, tt := range tests {
 		l := lexer.New(tt.input)
@@ -459,3 +503,95 @@ func testInfixExpression(t *testing.T, exp ast.IExpr, left interface{},
 	}
 	return true
 }
+
+func Test_nodesCarrySpans(t *testing.T) {
+	input := "let x = 1 +\n  add(2, 3);\nif (x) { x }"
+	l := lexer.New(input)
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	tests := []struct {
+		node     ast.Node
+		expected string
+	}{
+		{program, "1:1-3:13"},
+		{program.Statements[0], "1:1-2:12"},
+		{program.Statements[0].(*ast.LetStatement).Name, "1:5-1:6"},
+		{program.Statements[0].(*ast.LetStatement).Value, "1:9-2:12"},
+		{program.Statements[0].(*ast.LetStatement).Value.(*ast.InfixExpression).Right, "2:3-2:12"},
+		{program.Statements[1], "3:1-3:13"},
+		{program.Statements[1].(*ast.ExpressionStatement).Expression.(ast.IfExpression).Then, "3:8-3:13"},
+	}
+	for i, tt := range tests {
+		if actual := tt.node.Span().String(); actual != tt.expected {
+			t.Errorf("tests[%d] - span of %q wrong. expected=%s, got=%s",
+				i, tt.node, tt.expected, actual)
+		}
+	}
+}
+
+func Test_errorsCarryPositions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let x = 1;\nlet = 2;", "2:5: expected class IDENT"},
+		{"1 +\n\n  ;", "3:3: no prefix parse function for ;"},
+		{"let y = (1 + 2;", "1:15: there is no right parenthesis"},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%q: no errors reported", tt.input)
+		}
+		if !strings.HasPrefix(errors[0].Error(), tt.expected) {
+			t.Errorf("%q: wrong error. expected prefix %q, got=%q",
+				tt.input, tt.expected, errors[0])
+		}
+	}
+}
+
+func Test_errorsAreDiagnostics(t *testing.T) {
+	tests := []struct {
+		input        string
+		expectedCode string
+	}{
+		{"let = 2;", UnexpectedToken},
+		{"1 + ;", NoPrefixParseFunction},
+		{"(1 + 2;", UnclosedGroup},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		_, _ = p.ParseProgram()
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Fatalf("%q: no errors reported", tt.input)
+		}
+		d, ok := errors[0].(diagnostics.Diagnostic)
+		if !ok {
+			t.Fatalf("%q: error is not a diagnostics.Diagnostic. got=%T", tt.input, errors[0])
+		}
+		if d.Code != tt.expectedCode {
+			t.Errorf("%q: code wrong. expected=%s, got=%s", tt.input, tt.expectedCode, d.Code)
+		}
+	}
+}
+
+func Test_parseFromReader(t *testing.T) {
+	input := "{1: 2}; { let x = fun double(a) { a * 2 }; x(3) }"
+
+	l := lexer.NewReader(iotest.OneByteReader(strings.NewReader(input)))
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	expected := "{1: 2}{\n  let x = fun double(a) {\n    (a * 2)\n  };\n  x(3)\n}"
+	if program.String() != expected {
+		t.Fatalf("program.String() wrong. expected=%q, got=%q", expected, program.String())
+	}
+}
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parsePowerWithPrecedence(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			"2 ** 3",
+			"(2 ** 3)",
+		},
+		{
+			"2 ** 3 ** 2",
+			"(2 ** (3 ** 2))",
+		},
+		{
+			"a ** b ** c ** d",
+			"(a ** (b ** (c ** d)))",
+		},
+		{
+			"(2 ** 3) ** 2",
+			"((2 ** 3) ** 2)",
+		},
+		{
+			"2 * 3 ** 2",
+			"(2 * (3 ** 2))",
+		},
+		{
+			"2 ** 3 * 2",
+			"((2 ** 3) * 2)",
+		},
+		{
+			"1 + 2 ** 3 - 4",
+			"((1 + (2 ** 3)) - 4)",
+		},
+		{
+			"-2 ** 2",
+			"(-(2 ** 2))",
+		},
+		{
+			"2 ** -1",
+			"(2 ** (-1))",
+		},
+		{
+			"!a ** b",
+			"(!(a ** b))",
+		},
+		{
+			"a ** f(b)[0]",
+			"(a ** (f(b)[0]))",
+		},
+		{
+			"a ** 2 == b ** 2 && c",
+			"(((a ** 2) == (b ** 2)) && c)",
+		},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		checkParserErrors(t, p)
+		actual := program.String()
+		if actual != tt.expected {
+			t.Errorf("expected=%q, got=%q", tt.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package parser
+
+import (
+	"interpreter/lexer"
+	"strings"
+	"testing"
+)
+
+func Test_recoverFromErrors(t *testing.T) {
+	tests := []struct {
+		input              string
+		expectedErrors     []string
+		expectedStatements []string
+	}{
+		{
+			"let = 1; let x = 2; 1 + ; let y = 3; let if = 4;",
+			[]string{
+				`1:5: expected class IDENT, got = "="`,
+				`1:25: no prefix parse function for ; ";"`,
+				`1:42: expected class IDENT, got IF "if"`,
+			},
+			[]string{"let x = 2;", "let y = 3;"},
+		},
+		{
+			// the error is contained in the block, the function survives
+			"let f = fun f() { let = 1; 2 }; let z = 1",
+			[]string{`1:23: expected class IDENT, got = "="`},
+			[]string{"let f = fun f() {\n  2\n};", "let z = 1;"},
+		},
+		{
+			"} let a = 1",
+			[]string{`1:1: no prefix parse function for } "}"`},
+			[]string{"let a = 1;"},
+		},
+		{
+			// a statement keyword ends the broken statement without a `;`
+			"let x = (1 + ; return 5",
+			[]string{`1:14: no prefix parse function for ; ";"`},
+			[]string{"return 5;"},
+		},
+		{
+			"let x = 1 + 2 ) let y = 3",
+			[]string{`1:15: no prefix parse function for ) ")"`},
+			[]string{"let x = (1 + 2);", "let y = 3;"},
+		},
+		{
+			// lexical errors do not take the surrounding statements down
+			"let x = 1; @ let y = 2;",
+			[]string{"1:12: illegal token @"},
+			[]string{"let x = 1;", "let y = 2;"},
+		},
+		{
+			// the braces a broken statement opened are skipped with it
+			"if (x { 1 } let a = 2",
+			[]string{`1:7: expected class ), got { "{"`},
+			[]string{"let a = 2;"},
+		},
+		{
+			"fun f( { }; let y = 2",
+			[]string{`1:8: expected class IDENT, got { "{"`},
+			[]string{"let y = 2;"},
+		},
+		{
+			"while x { }",
+			[]string{`1:7: expected class (, got IDENT "x"`},
+			[]string{},
+		},
+		{
+			"while x { let a = 1; { 2 } } let b = 3",
+			[]string{`1:7: expected class (, got IDENT "x"`},
+			[]string{"let b = 3;"},
+		},
+		{
+			// a `}` the broken statement did not open still ends it
+			"{ let x = (1 + ; } let z = 1",
+			[]string{`1:16: no prefix parse function for ; ";"`},
+			[]string{"{\n}", "let z = 1;"},
+		},
+		{
+			"if (true) { 1",
+			[]string{"1:14: expected class }, got EOF"},
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(&l)
+		program, err := p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) != len(tt.expectedErrors) {
+			t.Fatalf("%q: wrong number of errors. expected=%d, got=%d (%v)",
+				tt.input, len(tt.expectedErrors), len(errors), errors)
+		}
+		for i, expected := range tt.expectedErrors {
+			if errors[i].Error() != expected {
+				t.Errorf("%q: errors[%d] wrong. expected=%q, got=%q",
+					tt.input, i, expected, errors[i].Error())
+			}
+		}
+		if err == nil || err.Error() != errors[0].Error() {
+			t.Errorf("%q: ParseProgram did not return the first error. got=%v", tt.input, err)
+		}
+
+		if len(program.Statements) != len(tt.expectedStatements) {
+			t.Fatalf("%q: wrong number of statements. expected=%d, got=%d",
+				tt.input, len(tt.expectedStatements), len(program.Statements))
+		}
+		for i, expected := range tt.expectedStatements {
+			if program.Statements[i] == nil {
+				t.Fatalf("%q: statements[%d] is nil", tt.input, i)
+			}
+			if program.Statements[i].String() != expected {
+				t.Errorf("%q: statements[%d] wrong. expected=%q, got=%q",
+					tt.input, i, expected, program.Statements[i].String())
+			}
+		}
+	}
+}
+
+func Test_recoverNeverKeepsNilStatements(t *testing.T) {
+	inputs := []string{
+		"", ";", ";;", "}", "}}}", "{", "{{", ")", "let", "let x", "let x =",
+		"return", "if", "if (", "if (1) {", "fun", "fun f(", "[1, 2", "{1: }",
+		"1 +", "!", "{ let = ; } }", "f(1, ", `"unterminated`, "/* open",
+		"if (1) [ = 1", "while [ = 1", "fun f( [ = 1", "if (1) ( = 1", "fun f( (1) = 2",
+	}
+	for _, input := range inputs {
+		l := lexer.New(input)
+		p := New(&l)
+		program, _ := p.ParseProgram()
+		for i, stmt := range program.Statements {
+			if stmt == nil {
+				t.Fatalf("%q: statements[%d] is nil", input, i)
+			}
+		}
+		for _, err := range p.Errors() {
+			if strings.TrimSpace(err.Error()) == "" {
+				t.Fatalf("%q: empty error", input)
+			}
+		}
+	}
+}
@@ -9,7 +9,6 @@ func (parser *Parser) tryReturnStatement() (ast.ReturnStatement, error) {
 	parser.eatToken()
 
 	stmt.Value = parser.tryExpression(LOWEST)
-	parser.eatToken()
 
 	return stmt, nil
 }
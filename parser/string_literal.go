@@ -0,0 +1,9 @@
+package parser
+
+import "interpreter/ast"
+
+func (parser *Parser) tryStringLiteralExpr() ast.IExpr {
+	t := parser.currentToken
+	parser.eatToken()
+	return &ast.StringLiteral{Token: t, Value: t.Literal}
+}
@@ -0,0 +1,56 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/lexer"
+	"testing"
+)
+
+func Test_parseStringLiteral(t *testing.T) {
+	input := `"hello\t\"world\"";`
+
+	l := lexer.New(input)
+	p := New(&l)
+	program, _ := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain %d statements. got=%d\n",
+			1, len(program.Statements))
+	}
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got=%T",
+			program.Statements[0])
+	}
+	literal, ok := stmt.Expression.(*ast.StringLiteral)
+	if !ok {
+		t.Fatalf("exp not *ast.StringLiteral. got=%T", stmt.Expression)
+	}
+	if literal.Value != "hello\t\"world\"" {
+		t.Errorf("literal.Value not %q. got=%q", "hello\t\"world\"", literal.Value)
+	}
+	if literal.String() != input[:len(input)-1] {
+		t.Errorf("literal.String() not %q. got=%q", input[:len(input)-1], literal.String())
+	}
+}
+
+func Test_stringLiteralStringRoundTrips(t *testing.T) {
+	tests := []string{
+		"plain",
+		"tab\tnew\nline",
+		`quote " and backslash \`,
+		"bell \a and größe",
+	}
+	for _, value := range tests {
+		printed := ast.StringLiteral{Value: value}.String()
+		l := lexer.New(printed)
+		tok, err := l.NextToken()
+		if err != nil {
+			t.Fatalf("%q: printed form %s does not lex: %v", value, printed, err)
+		}
+		if tok.Literal != value {
+			t.Errorf("%q: printed form %s lexes to %q", value, printed, tok.Literal)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/token"
+)
+
+func (parser *Parser) tryWhileExpr() ast.IExpr {
+	expr := ast.WhileExpression{Token: parser.currentToken}
+	parser.eatToken()
+
+	if !parser.tryToken(token.LPAREN) {
+		return nil
+	}
+	expr.Condition = parser.tryExpression(LOWEST)
+	if !parser.tryToken(token.RPAREN) {
+		return nil
+	}
+
+	body, ok := parser.tryLoopBody()
+	if !ok {
+		return nil
+	}
+	expr.Body = &body
+	return expr
+}
+
+// tryLoopBody parses the block of a loop, inside which break and continue
+// are allowed.
+func (parser *Parser) tryLoopBody() (ast.BlockStatement, bool) {
+	parser.loops++
+	body, ok := parser.tryBlock()
+	parser.loops--
+	return body, ok
+}
+
+// tryLoopControlStatement parses `break` and `continue`, which must be
+// inside a loop of the same function.
+func (parser *Parser) tryLoopControlStatement() (ast.Statement, error) {
+	t := parser.currentToken
+	if parser.loops == 0 {
+		return nil, diagnostics.New(
+			MisplacedLoopControl, t.Span, "%s outside of a loop", t.Literal,
+		).WithHint("%s can only be used in the body of a while or for loop", t.Literal)
+	}
+	parser.eatToken()
+	if t.Class == token.BREAK {
+		return &ast.BreakStatement{Token: t}, nil
+	}
+	return &ast.ContinueStatement{Token: t}, nil
+}
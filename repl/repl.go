@@ -3,7 +3,11 @@ package repl
 import (
 	"bufio"
 	"fmt"
+	"interpreter/ast"
+	"interpreter/diagnostics"
+	"interpreter/evaluator"
 	"interpreter/lexer"
+	"interpreter/object"
 	"interpreter/parser"
 	"io"
 )
@@ -13,6 +17,7 @@ const QUIT = ":q"
 
 func Start(in io.Reader, out io.Writer) {
 	scanner := bufio.NewScanner(in)
+	env := object.NewEnvironment()
 	show := func(format string, args ...any) {
 		_, _ = fmt.Fprintf(out, format, args...)
 	}
@@ -35,15 +40,34 @@ func Start(in io.Reader, out io.Writer) {
 		lex := lexer.New(line)
 		p := parser.New(&lex)
 		program, err := p.ParseProgram()
-		if err != nil {
+		if err != nil || len(p.Errors()) > 0 {
 			show("Your fucked up\n")
 			for _, msg := range p.Errors() {
-				show("\t%s\n", msg)
+				if d, ok := msg.(diagnostics.Diagnostic); ok {
+					show("%s", d.Render(line))
+				} else {
+					show("\t%s\n", msg)
+				}
 			}
-		} else {
-			show("%+v\n", program)
+			continue
 		}
 
+		evaluated, err := evaluator.Eval(program, env)
+		if err != nil {
+			show("ERROR: %s\n", err)
+		} else if hasValue(program) {
+			show("%s\n", evaluated.Inspect())
+		}
 	}
 	_, _ = fmt.Fprintln(out, "Bye!")
 }
+
+// hasValue tells whether a line ends in something worth echoing; a blank
+// line or a let evaluates to null, which would only be noise.
+func hasValue(program *ast.Program) bool {
+	if len(program.Statements) == 0 {
+		return false
+	}
+	_, isLet := program.Statements[len(program.Statements)-1].(*ast.LetStatement)
+	return !isLet
+}
@@ -0,0 +1,52 @@
+package repl
+
+import (
+	"fmt"
+	"github.com/repeale/fp-go"
+	"strings"
+	"testing"
+)
+
+func TestItShouldPrintEvaluatedValue(t *testing.T) {
+	const program = "let x = 3; let y = 8; x * y / 2 + 3 * 8 - 12"
+	input := strings.NewReader(fmt.Sprintf("%v\n%v\n", program, QUIT))
+	var output Output
+	Start(input, &output)
+
+	if !fp.Some(
+		func(line string) bool { return line == "24\n" },
+	)(output) {
+		t.Log(output)
+		t.Fatalf("evaluated value is not shown")
+	}
+}
+
+func TestItShouldKeepBindingsBetweenLines(t *testing.T) {
+	input := strings.NewReader(fmt.Sprintf("let x = 41;\nx + 1\n%v\n", QUIT))
+	var output Output
+	Start(input, &output)
+
+	if !fp.Some(
+		func(line string) bool { return line == "42\n" },
+	)(output) {
+		t.Log(output)
+		t.Fatalf("binding from previous line is lost")
+	}
+}
+
+func TestItShouldNotEchoLets(t *testing.T) {
+	input := strings.NewReader(fmt.Sprintf("let x = 41;\n\nif (false) { 1 }\n%v\n", QUIT))
+	var output Output
+	Start(input, &output)
+
+	nulls := 0
+	for _, line := range output {
+		if line == "null\n" {
+			nulls++
+		}
+	}
+	if nulls != 1 {
+		t.Log(output)
+		t.Fatalf("expected only the if to show null, got %d nulls", nulls)
+	}
+}
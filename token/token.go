@@ -1,24 +1,36 @@
 package token
 
+import "fmt"
+
 const (
 	ILLEGAL   = "ILLEGAL"
 	EOF       = "EOF"
 	IDENT     = "IDENT"
 	INT       = "INT"
+	FLOAT     = "FLOAT"
+	STRING    = "STRING"
+	COMMENT   = "COMMENT"
 	ASSIGN    = "="
 	PLUS      = "+"
 	MINUS     = "-"
 	BANG      = "!"
 	ASTERISK  = "*"
+	POWER     = "**"
 	SLASH     = "/"
 	LT        = "<"
 	GT        = ">"
+	LTE       = "<="
+	GTE       = ">="
+	PERCENT   = "%"
 	COMMA     = ","
+	COLON     = ":"
 	SEMICOLON = ";"
 	LPAREN    = "("
 	RPAREN    = ")"
 	LBRACE    = "{"
 	RBRACE    = "}"
+	LBRACKET  = "["
+	RBRACKET  = "]"
 	FUNCTION  = "FUNCTION"
 	LET       = "LET"
 	TRUE      = "TRUE"
@@ -26,17 +38,61 @@ const (
 	IF        = "IF"
 	ELSE      = "ELSE"
 	RETURN    = "RETURN"
+	WHILE     = "WHILE"
+	FOR       = "FOR"
+	IN        = "IN"
+	BREAK     = "BREAK"
+	CONTINUE  = "CONTINUE"
 	EQUAL     = "=="
 	UNEQUAL   = "!="
 	LOGICAND  = "&&"
 	LOGICOR   = "||"
+	ARROW     = "=>"
+	ELLIPSIS  = "..."
+
+	PLUS_ASSIGN     = "+="
+	MINUS_ASSIGN    = "-="
+	ASTERISK_ASSIGN = "*="
+	SLASH_ASSIGN    = "/="
+	PERCENT_ASSIGN  = "%="
+	POWER_ASSIGN    = "**="
 )
 
 type Class string
 
+// Position is a location in the source. Offset counts bytes from the start
+// of the input. Line, Column and ByteColumn count from 1; Column counts runes
+// from the start of the line, ByteColumn counts bytes.
+type Position struct {
+	Offset     int
+	Line       int
+	Column     int
+	ByteColumn int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Span covers the source from Start up to, but not including, End.
+type Span struct {
+	Start Position
+	End   Position
+}
+
+func (s Span) String() string {
+	return fmt.Sprintf("%v-%v", s.Start, s.End)
+}
+
+// To returns a span from the start of s to the end of other.
+func (s Span) To(other Span) Span {
+	return Span{Start: s.Start, End: other.End}
+}
+
 type Token struct {
 	Class   Class
 	Literal string
+	Span    Span
 }
 
 func New(t Class, literal string) Token {